@@ -0,0 +1,3 @@
+data "ctfd_challenge" "warmup" {
+  name = "Warmup"
+}
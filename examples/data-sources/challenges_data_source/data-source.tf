@@ -1 +1,6 @@
 data "ctfd_challenges" "challenges" {}
+
+data "ctfd_challenges" "hidden_web" {
+  category   = "Web"
+  visibility = "hidden"
+}
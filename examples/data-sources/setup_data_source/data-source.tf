@@ -0,0 +1,5 @@
+data "ctfd_setup" "setup" {}
+
+output "user_mode" {
+  value = data.ctfd_setup.setup.user_mode
+}
@@ -0,0 +1,3 @@
+data "ctfd_team" "red" {
+  name = "Red Team"
+}
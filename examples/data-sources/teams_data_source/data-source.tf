@@ -0,0 +1,8 @@
+data "ctfd_teams" "teams" {
+  per_page = 100
+}
+
+data "ctfd_teams" "students" {
+  affiliation = "University of Example"
+  name_regex  = "^uoe-"
+}
@@ -0,0 +1,3 @@
+data "ctfd_user" "alice" {
+  email = "alice@example.com"
+}
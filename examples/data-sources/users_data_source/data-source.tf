@@ -0,0 +1,4 @@
+data "ctfd_users" "unverified" {
+  type     = "user"
+  verified = false
+}
@@ -0,0 +1,4 @@
+# Files are imported using `challenge_id.file_id`. `source` and
+# `content_sha256` cannot be read back, so must be set in configuration; the
+# file is only uploaded again if `source` differs from the file in CTFd.
+terraform import ctfd_challenge_file.warmup 1.2
@@ -0,0 +1,5 @@
+resource "ctfd_challenge_file" "warmup" {
+  challenge_id   = ctfd_challenge.warmup.id
+  source         = "${path.module}/files/warmup.zip"
+  content_sha256 = filesha256("${path.module}/files/warmup.zip")
+}
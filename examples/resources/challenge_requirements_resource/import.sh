@@ -0,0 +1,2 @@
+# Requirements are imported using the ID of the challenge they belong to.
+terraform import ctfd_challenge_requirements.crypto 2
@@ -0,0 +1,5 @@
+resource "ctfd_challenge_requirements" "crypto" {
+  challenge_id  = ctfd_dynamic_challenge.crypto.id
+  prerequisites = [ctfd_challenge.warmup.id]
+  anonymize     = true
+}
@@ -0,0 +1 @@
+terraform import ctfd_challenge.warmup 1
@@ -0,0 +1,11 @@
+resource "ctfd_challenge" "warmup" {
+  name            = "Warm-up"
+  description     = "Find the flag in the page source."
+  category        = "Web"
+  value           = 100
+  state           = "visible"
+  max_attempts    = 0
+  connection_info = "https://warmup.example.com"
+  tags            = ["beginner"]
+  topics          = ["HTML"]
+}
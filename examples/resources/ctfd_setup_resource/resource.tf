@@ -3,6 +3,7 @@ resource "ctfd_setup" "setup" {
   description        = "Example CTFd setup."
   admin_email        = "admin@example.com"
   configuration_path = "/tmp/juice-shop-ctf.zip"
+  user_mode          = "teams"
 
   email {
     username     = "admin@example.com"
@@ -0,0 +1 @@
+terraform import ctfd_dynamic_challenge.crypto 2
@@ -0,0 +1,9 @@
+resource "ctfd_dynamic_challenge" "crypto" {
+  name        = "Crypto 101"
+  description = "Decrypt the message."
+  category    = "Crypto"
+  initial     = 500
+  decay       = 20
+  minimum     = 100
+  function    = "logarithmic"
+}
@@ -0,0 +1 @@
+terraform import ctfd_flag.warmup 1
@@ -0,0 +1,12 @@
+resource "ctfd_flag" "warmup" {
+  challenge_id = ctfd_challenge.warmup.id
+  type         = "static"
+  content      = "flag{view-source}"
+  data         = "case_insensitive"
+}
+
+resource "ctfd_flag" "warmup_regex" {
+  challenge_id = ctfd_challenge.warmup.id
+  type         = "regex"
+  content      = "flag\\{view-?source\\}"
+}
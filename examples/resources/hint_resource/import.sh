@@ -0,0 +1 @@
+terraform import ctfd_hint.first 1
@@ -0,0 +1,16 @@
+resource "ctfd_hint" "first" {
+  challenge_id = ctfd_challenge.warmup.id
+  title        = "Where to look"
+  content      = "Try viewing the page source."
+  cost         = 10
+}
+
+resource "ctfd_hint" "second" {
+  challenge_id = ctfd_challenge.warmup.id
+  content      = "Look for an HTML comment."
+  cost         = 25
+
+  requirements {
+    prerequisites = [ctfd_hint.first.id]
+  }
+}
@@ -0,0 +1,2 @@
+# The password cannot be read back from CTFd, so it will show as changed after import.
+terraform import ctfd_user.user 1
@@ -0,0 +1,2 @@
+# Memberships are imported using `team_id.user_id`.
+terraform import ctfd_user_team_membership.user_team 1.2
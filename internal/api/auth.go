@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -12,30 +13,65 @@ import (
 
 var nonceRegex = regexp.MustCompile("'csrfNonce': \"([a-z0-9]+)\",")
 
-// GetNonce - For use in subsequent requests
-func (client *Client) setNonce(path string) error {
+// getNonce - the CSRF nonce of the current session, scraped from `path` if
+// not already known; CTFd issues one nonce per session, so it is cached until
+// the session changes
+func (client *Client) getNonce(ctx context.Context, path string) (string, error) {
+	client.authMu.Lock()
+	defer client.authMu.Unlock()
+
+	if client.Auth.Nonce != "" {
+		return client.Auth.Nonce, nil
+	}
+
 	path = strings.TrimLeft(path, "/")
-	res, err := client.HttpClient.Get(fmt.Sprintf("%s/%s", client.HostUrl, path))
+	res, err := client.get(ctx, fmt.Sprintf("%s/%s", client.HostUrl, path))
 	if err != nil {
-		return err
+		return "", err
 	}
+	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return err
+		return "", err
 	}
-	defer res.Body.Close()
 
 	parts := nonceRegex.FindSubmatch(body)
-	nonce := parts[1]
-	client.Auth.Nonce = string(nonce)
+	if parts == nil {
+		return "", fmt.Errorf("unable to find nonce in /%s", path)
+	}
+	client.Auth.Nonce = string(parts[1])
 
-	return nil
+	return client.Auth.Nonce, nil
+}
+
+// resetNonce - discard the cached nonce, e.g. after signing in
+func (client *Client) resetNonce() {
+	client.authMu.Lock()
+	defer client.authMu.Unlock()
+
+	client.Auth.Nonce = ""
+}
+
+// GetToken - the API token sent with each request
+func (client *Client) GetToken() string {
+	client.authMu.Lock()
+	defer client.authMu.Unlock()
+
+	return client.Auth.Token
+}
+
+// SetToken - set the API token sent with each request
+func (client *Client) SetToken(token string) {
+	client.authMu.Lock()
+	defer client.authMu.Unlock()
+
+	client.Auth.Token = token
 }
 
 // CheckSetup - verify that a CTFd instance has been setup
-func (client *Client) CheckSetup() error {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/login", client.HostUrl), nil)
+func (client *Client) CheckSetup(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/login", client.HostUrl), nil)
 	if err != nil {
 		return err
 	}
@@ -56,21 +92,23 @@ func (client *Client) CheckSetup() error {
 }
 
 // SignIn - Authenticate the Admin. user
-func (client *Client) SignIn() error {
-	err := client.setNonce("/login")
+func (client *Client) SignIn(ctx context.Context) error {
+	client.resetNonce()
+	nonce, err := client.getNonce(ctx, "/login")
 	if err != nil {
 		return err
 	}
 
 	form := url.Values{}
-	form.Set("nonce", client.Auth.Nonce)
+	form.Set("nonce", nonce)
 	form.Set("name", client.Auth.Username)
 	form.Set("password", client.Auth.Password)
 
-	res, err := client.HttpClient.PostForm(fmt.Sprintf("%s/login", client.HostUrl), form)
+	res, err := client.postForm(ctx, fmt.Sprintf("%s/login", client.HostUrl), form)
 	if err != nil {
 		return err
 	}
+	client.resetNonce()
 	if res.StatusCode != 302 {
 		location := res.Header.Get("Location")
 		if !strings.HasSuffix(location, "/challenges") {
@@ -82,8 +120,8 @@ func (client *Client) SignIn() error {
 }
 
 // SignOut - Request the logout page
-func (client *Client) SignOut() error {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/logout", client.HostUrl), nil)
+func (client *Client) SignOut(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/logout", client.HostUrl), nil)
 	if err != nil {
 		return err
 	}
@@ -92,6 +130,7 @@ func (client *Client) SignOut() error {
 	if err != nil {
 		return err
 	}
+	client.resetNonce()
 
 	return nil
 }
@@ -1,14 +1,99 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
-// GetChallenges - Returns list of challenges
-func (client *Client) GetChallenges() (interface{}, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/challenges", client.HostUrl), nil)
+// NewChallenge - fields required when creating a new challenge
+type NewChallenge struct {
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Category       string `json:"category"`
+	Value          int    `json:"value"`
+	State          string `json:"state"`
+	MaxAttempts    int    `json:"max_attempts"`
+	ConnectionInfo string `json:"connection_info"`
+	Type           string `json:"type"`
+}
+
+// Challenge - fields as returned from the CTFd API
+type Challenge struct {
+	Id             uint   `json:"id"`
+	Name           string `json:"name"`
+	Description    string `json:"description"`
+	Category       string `json:"category"`
+	Value          int    `json:"value"`
+	State          string `json:"state"`
+	MaxAttempts    int    `json:"max_attempts"`
+	ConnectionInfo string `json:"connection_info"`
+	Type           string `json:"type"`
+
+	// fields only populated by some endpoints, e.g. the list of challenges
+	Solves     int           `json:"solves"`
+	SolvedByMe bool          `json:"solved_by_me"`
+	Tags       ChallengeTags `json:"tags"`
+	Template   string        `json:"template"`
+	Script     string        `json:"script"`
+}
+
+// ChallengeTags - tag values of a challenge; CTFd returns these as objects,
+// e.g. `{"value": "web"}`, in the list of challenges but as plain strings for
+// a single challenge
+type ChallengeTags []string
+
+func (tags *ChallengeTags) UnmarshalJSON(data []byte) error {
+	raw := make([]json.RawMessage, 0)
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return err
+	}
+
+	values := make(ChallengeTags, 0, len(raw))
+	for _, item := range raw {
+		var value string
+		if json.Unmarshal(item, &value) != nil {
+			tag := new(Tag)
+			err = json.Unmarshal(item, tag)
+			if err != nil {
+				return err
+			}
+			value = tag.Value
+		}
+		values = append(values, value)
+	}
+	*tags = values
+
+	return nil
+}
+
+// GetChallenges - Returns list of challenges, across all pages
+func (client *Client) GetChallenges(ctx context.Context, options ListOptions) ([]Challenge, error) {
+	challenges := make([]Challenge, 0)
+	err := client.getAllPages(ctx, "/api/v1/challenges", options, func(body *json.RawMessage) error {
+		page := make([]Challenge, 0)
+		err := json.Unmarshal(*body, &page)
+		if err != nil {
+			return err
+		}
+		challenges = append(challenges, page...)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return challenges, nil
+}
+
+// GetChallenge - Returns details of a challenge
+func (client *Client) GetChallenge(ctx context.Context, id uint) (*Challenge, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/challenges/%d", client.HostUrl, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -18,11 +103,135 @@ func (client *Client) GetChallenges() (interface{}, error) {
 		return nil, err
 	}
 
-	challenges := make([]map[string]interface{}, 0)
-	err = json.Unmarshal(*body, &challenges)
+	challenge := new(Challenge)
+	err = json.Unmarshal(*body, &challenge)
 	if err != nil {
 		return nil, err
 	}
 
-	return challenges, nil
+	return challenge, nil
+}
+
+// CreateChallenge - create a new challenge
+func (client *Client) CreateChallenge(ctx context.Context, challenge NewChallenge) (*Challenge, error) {
+	rb, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/challenges", client.HostUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newChallenge := new(Challenge)
+	err = json.Unmarshal(*body, &newChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return newChallenge, nil
+}
+
+// UpdateChallenge - updated an existing challenge
+func (client *Client) UpdateChallenge(ctx context.Context, id uint, challenge NewChallenge) (*Challenge, error) {
+	rb, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/challenges/%d", client.HostUrl, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedChallenge := new(Challenge)
+	err = json.Unmarshal(*body, &updatedChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedChallenge, nil
+}
+
+// DeleteChallenge - remove an existing challenge
+func (client *Client) DeleteChallenge(ctx context.Context, id uint) error {
+	emptyRequest := []byte("{}")
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/challenges/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ChallengeRequirements - challenges which must be solved before this one
+type ChallengeRequirements struct {
+	Prerequisites []uint `json:"prerequisites"`
+	Anonymize     bool   `json:"anonymize"`
+}
+
+// GetChallengeRequirements - Returns the requirements of a challenge
+func (client *Client) GetChallengeRequirements(ctx context.Context, id uint) (*ChallengeRequirements, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/challenges/%d/requirements", client.HostUrl, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := new(ChallengeRequirements)
+	if body == nil {
+		return requirements, nil
+	}
+	err = json.Unmarshal(*body, &requirements)
+	if err != nil {
+		return nil, err
+	}
+
+	return requirements, nil
+}
+
+// UpdateChallengeRequirements - replace the requirements of a challenge
+func (client *Client) UpdateChallengeRequirements(ctx context.Context, id uint, requirements ChallengeRequirements) error {
+	if requirements.Prerequisites == nil {
+		requirements.Prerequisites = []uint{}
+	}
+	challenge := map[string]interface{}{
+		"requirements": requirements,
+	}
+	rb, err := json.Marshal(challenge)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/challenges/%d", client.HostUrl, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestChallengeTagsUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		name string
+		json string
+		want ChallengeTags
+	}{
+		{
+			name: "objects, as in the list of challenges",
+			json: `[{"value": "web"}, {"value": "easy"}]`,
+			want: ChallengeTags{"web", "easy"},
+		},
+		{
+			name: "strings, as for a single challenge",
+			json: `["web", "easy"]`,
+			want: ChallengeTags{"web", "easy"},
+		},
+		{
+			name: "mixed",
+			json: `["web", {"id": 1, "challenge_id": 2, "value": "easy"}]`,
+			want: ChallengeTags{"web", "easy"},
+		},
+		{
+			name: "empty",
+			json: `[]`,
+			want: ChallengeTags{},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var tags ChallengeTags
+			if err := json.Unmarshal([]byte(c.json), &tags); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(tags, c.want) {
+				t.Errorf("got %#v; want %#v", tags, c.want)
+			}
+		})
+	}
+}
+
+func TestChallengeTagsUnmarshalJSONInvalid(t *testing.T) {
+	for _, invalid := range []string{`"web"`, `[1]`, `[{"value": 1}]`} {
+		var tags ChallengeTags
+		if err := json.Unmarshal([]byte(invalid), &tags); err == nil {
+			t.Errorf("%s: expected an error; got %#v", invalid, tags)
+		}
+	}
+}
+
+func TestChallengeUnmarshalJSONTags(t *testing.T) {
+	var challenges []Challenge
+	err := json.Unmarshal([]byte(`[
+		{"id": 1, "name": "Warmup", "solves": null, "tags": [{"value": "web"}]},
+		{"id": 2, "name": "Crypto", "solves": 3, "tags": ["crypto"]}
+	]`), &challenges)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Challenge{
+		{Id: 1, Name: "Warmup", Tags: ChallengeTags{"web"}},
+		{Id: 2, Name: "Crypto", Solves: 3, Tags: ChallengeTags{"crypto"}},
+	}
+	if !reflect.DeepEqual(challenges, want) {
+		t.Errorf("got %#v; want %#v", challenges, want)
+	}
+}
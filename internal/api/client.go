@@ -1,21 +1,34 @@
 package api
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
+	"mime/multipart"
 	"net/http"
 	"net/http/cookiejar"
+	"net/url"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"time"
 )
 
 // Client -
 type Client struct {
-	HostUrl    string
-	HttpClient *http.Client
-	Auth       AuthStruct
-	UserAgent  string
+	HostUrl     string
+	HttpClient  *http.Client
+	Auth        AuthStruct
+	UserAgent   string
+	RetryPolicy RetryPolicy
+	RateLimiter *RateLimiter
+
+	// authMu - guards the nonce and token in Auth, which are shared by all
+	// resources operating in parallel
+	authMu sync.Mutex
 }
 
 // AuthStruct -
@@ -46,6 +59,7 @@ type ApiResponse struct {
 	Meta    *Meta            `json:"meta"`
 	Success bool             `json:"success"`
 	Message string           `json:"message"`
+	Errors  *json.RawMessage `json:"errors"`
 	Data    *json.RawMessage `json:"data"`
 }
 
@@ -53,7 +67,8 @@ type ApiResponse struct {
 func NewClient(host, username, password *string, userAgent *string) (*Client, error) {
 	c := Client{
 		HttpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Transport: newLoggingTransport(http.DefaultTransport),
+			Timeout:   30 * time.Second,
 			CheckRedirect: func(req *http.Request, via []*http.Request) error {
 				return http.ErrUseLastResponse
 			},
@@ -63,7 +78,8 @@ func NewClient(host, username, password *string, userAgent *string) (*Client, er
 			Username: *username,
 			Password: *password,
 		},
-		UserAgent: *userAgent,
+		UserAgent:   *userAgent,
+		RetryPolicy: DefaultRetryPolicy,
 	}
 
 	jar, err := cookiejar.New(nil)
@@ -76,38 +92,85 @@ func NewClient(host, username, password *string, userAgent *string) (*Client, er
 }
 
 func (client *Client) DoApiRequest(req *http.Request) (*json.RawMessage, error) {
-	req.Header.Set("Authorization", fmt.Sprintf("Basic %s", client.Auth.Token))
-	req.Header.Set("Content-Type", "application/json")
+	body, _, err := client.DoApiRequestWithMeta(req)
 
-	res, err := client.HttpClient.Do(req)
+	return body, err
+}
+
+// DoApiRequestWithMeta - as `DoApiRequest`, also returning the response's
+// `meta`, e.g. pagination of list endpoints; `nil` if absent
+func (client *Client) DoApiRequestWithMeta(req *http.Request) (*json.RawMessage, *Meta, error) {
+	if token := client.GetToken(); token != "" {
+		req.Header.Set("Authorization", fmt.Sprintf("Token %s", token))
+	}
+	if req.Header.Get("Content-Type") == "" {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := client.doWithRetry(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	if res.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("status: %d", res.StatusCode)
+		return nil, nil, newStatusError(res.StatusCode, errorMessage(res.Body))
 	}
 
 	result := new(ApiResponse)
 	err = json.NewDecoder(res.Body).Decode(result)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if !result.Success {
-		return nil, fmt.Errorf("success: %v", result.Success)
+		return nil, nil, fmt.Errorf("success: %v", result.Success)
+	}
+
+	return result.Data, result.Meta, err
+}
+
+// get - GET a page, as `http.Client.Get` but bound to a context
+func (client *Client) get(ctx context.Context, location string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", location, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	return result.Data, err
+	return client.HttpClient.Do(req)
+}
+
+// postForm - POST a form, as `http.Client.PostForm` but bound to a context
+func (client *Client) postForm(ctx context.Context, location string, form url.Values) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", location, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return client.HttpClient.Do(req)
+}
+
+// errorMessage - best-effort extraction of the message from an error response
+func errorMessage(body io.Reader) string {
+	result := new(ApiResponse)
+	err := json.NewDecoder(body).Decode(result)
+	if err != nil {
+		return ""
+	}
+	if result.Message == "" && result.Errors != nil {
+		return string(*result.Errors)
+	}
+
+	return result.Message
 }
 
 func (client *Client) DoRequest(req *http.Request) (io.ReadCloser, error) {
-	err := client.setNonce("/settings")
+	nonce, err := client.getNonce(req.Context(), "/settings")
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("CSRF-Token", client.Auth.Nonce)
+	req.Header.Set("CSRF-Token", nonce)
 
 	res, err := client.HttpClient.Do(req)
 	if err != nil {
@@ -121,3 +184,38 @@ func (client *Client) DoRequest(req *http.Request) (io.ReadCloser, error) {
 
 	return res.Body, err
 }
+
+// newMultipartBody - encode form fields and a file as multipart/form-data
+func newMultipartBody(fields map[string]string, fileField string, path string) (*bytes.Buffer, string, error) {
+	body := new(bytes.Buffer)
+	writer := multipart.NewWriter(body)
+
+	for key, value := range fields {
+		err := writer.WriteField(key, value)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	part, err := writer.CreateFormFile(fileField, filepath.Base(path))
+	if err != nil {
+		return nil, "", err
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(part, file)
+	if err != nil {
+		return nil, "", err
+	}
+	err = writer.Close()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return body, writer.FormDataContentType(), nil
+}
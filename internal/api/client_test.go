@@ -0,0 +1,203 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+// stubCtfd - a minimal CTFd, issuing a new CSRF nonce each time a page
+// embedding one is served, and accepting only nonces it has issued
+type stubCtfd struct {
+	*httptest.Server
+
+	mu     sync.Mutex
+	nonces map[string]bool
+
+	settingsScrapes atomic.Int64
+	loginScrapes    atomic.Int64
+}
+
+func newStubCtfd(t *testing.T) *stubCtfd {
+	t.Helper()
+
+	stub := &stubCtfd{nonces: map[string]bool{}}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /settings", func(w http.ResponseWriter, r *http.Request) {
+		stub.settingsScrapes.Add(1)
+		stub.writeNoncePage(w)
+	})
+	mux.HandleFunc("GET /login", func(w http.ResponseWriter, r *http.Request) {
+		stub.loginScrapes.Add(1)
+		stub.writeNoncePage(w)
+	})
+	mux.HandleFunc("POST /login", func(w http.ResponseWriter, r *http.Request) {
+		if !stub.validNonce(r.FormValue("nonce")) {
+			http.Error(w, "invalid nonce", http.StatusForbidden)
+			return
+		}
+		http.Redirect(w, r, "/challenges", http.StatusFound)
+	})
+	mux.HandleFunc("GET /admin/statistics", func(w http.ResponseWriter, r *http.Request) {
+		if !stub.validNonce(r.Header.Get("CSRF-Token")) {
+			http.Error(w, "invalid nonce", http.StatusForbidden)
+			return
+		}
+		fmt.Fprint(w, "ok")
+	})
+	mux.HandleFunc("GET /api/v1/tokens", func(w http.ResponseWriter, r *http.Request) {
+		if !stub.validNonce(r.Header.Get("CSRF-Token")) {
+			http.Error(w, `{"success": false, "message": "invalid nonce"}`, http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"success": true, "data": [{"id": 1, "type": "user"}]}`)
+	})
+	mux.HandleFunc("GET /api/v1/challenges", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"success": true, "data": [{"id": 1, "name": "Warmup", "tags": [{"value": "web"}]}]}`)
+	})
+
+	stub.Server = httptest.NewServer(mux)
+	t.Cleanup(stub.Close)
+
+	return stub
+}
+
+func (stub *stubCtfd) writeNoncePage(w http.ResponseWriter) {
+	stub.mu.Lock()
+	nonce := fmt.Sprintf("%x", len(stub.nonces)+1)
+	stub.nonces[nonce] = true
+	stub.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/html")
+	fmt.Fprintf(w, "<script>var init = {'csrfNonce': \"%s\",}</script>", nonce)
+}
+
+func (stub *stubCtfd) validNonce(nonce string) bool {
+	stub.mu.Lock()
+	defer stub.mu.Unlock()
+
+	return stub.nonces[nonce]
+}
+
+func newStubClient(t *testing.T, stub *stubCtfd) *Client {
+	t.Helper()
+
+	host, username, password, userAgent := stub.URL, "admin", "password", "test"
+	client, err := NewClient(&host, &username, &password, &userAgent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("token")
+
+	return client
+}
+
+// hammer - run `calls` from many goroutines at once, failing on any error
+func hammer(t *testing.T, goroutines int, calls ...func() error) {
+	t.Helper()
+
+	var wg sync.WaitGroup
+	errs := make(chan error, goroutines*len(calls))
+	for i := 0; i < goroutines; i++ {
+		for _, call := range calls {
+			wg.Add(1)
+			go func(call func() error) {
+				defer wg.Done()
+				if err := call(); err != nil {
+					errs <- err
+				}
+			}(call)
+		}
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+}
+
+func TestClientConcurrentRequestsShareNonce(t *testing.T) {
+	stub := newStubCtfd(t)
+	client := newStubClient(t, stub)
+	ctx := context.Background()
+
+	hammer(t, 20,
+		func() error {
+			req, err := http.NewRequestWithContext(ctx, "GET", stub.URL+"/api/v1/challenges", nil)
+			if err != nil {
+				return err
+			}
+			_, err = client.DoApiRequest(req)
+			return err
+		},
+		func() error {
+			req, err := http.NewRequestWithContext(ctx, "GET", stub.URL+"/admin/statistics", nil)
+			if err != nil {
+				return err
+			}
+			_, err = client.DoRequest(req)
+			return err
+		},
+		func() error {
+			_, err := client.GetTokens(ctx)
+			return err
+		},
+		func() error {
+			client.SetToken(client.GetToken())
+			return nil
+		},
+	)
+
+	if scrapes := stub.settingsScrapes.Load(); scrapes != 1 {
+		t.Errorf("nonce scraped %d times in one session; expected once", scrapes)
+	}
+}
+
+func TestClientConcurrentSignIn(t *testing.T) {
+	stub := newStubCtfd(t)
+	client := newStubClient(t, stub)
+	ctx := context.Background()
+
+	const goroutines = 20
+
+	hammer(t, goroutines,
+		func() error {
+			return client.SignIn(ctx)
+		},
+		func() error {
+			req, err := http.NewRequestWithContext(ctx, "GET", stub.URL+"/api/v1/challenges", nil)
+			if err != nil {
+				return err
+			}
+			_, err = client.DoApiRequest(req)
+			return err
+		},
+		func() error {
+			req, err := http.NewRequestWithContext(ctx, "GET", stub.URL+"/admin/statistics", nil)
+			if err != nil {
+				return err
+			}
+			_, err = client.DoRequest(req)
+			return err
+		},
+		func() error {
+			_, err := client.GetTokens(ctx)
+			return err
+		},
+	)
+
+	// each sign in starts at most two sessions, i.e. resets the nonce twice,
+	// and a nonce should be scraped at most once per session
+	scrapes := stub.settingsScrapes.Load() + stub.loginScrapes.Load()
+	if limit := int64(2*goroutines + 1); scrapes > limit {
+		t.Errorf("nonce scraped %d times in at most %d sessions", scrapes, limit)
+	}
+}
@@ -1,19 +1,22 @@
 package api
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
-	"mime/multipart"
 	"net/http"
 	"net/url"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 )
 
+// user modes of a CTFd instance: teams of users, or individual users
+const (
+	UserModeTeams = "teams"
+	UserModeUsers = "users"
+)
+
 type configData struct {
 	Id    uint   `json:"id"`
 	Value string `json:"value"`
@@ -37,12 +40,13 @@ type CtfdSetup struct {
 	Description       string       `json:"description"`
 	AdminEmail        string       `json:"admin_email"`
 	ConfigurationPath string       `json:"configuration_path"`
+	UserMode          string       `json:"user_mode"`
 	Email             *EmailConfig `json:"email"`
 }
 
 // GetCtfdSetup - Retrieve details of the CTFd setup
-func (client *Client) GetCtfdSetup() (*CtfdSetup, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/configs", client.HostUrl), nil)
+func (client *Client) GetCtfdSetup(ctx context.Context) (*CtfdSetup, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/configs", client.HostUrl), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -65,6 +69,8 @@ func (client *Client) GetCtfdSetup() (*CtfdSetup, error) {
 			ctfdSetup.Name = value.Value
 		case "ctf_description":
 			ctfdSetup.Description = value.Value
+		case "user_mode":
+			ctfdSetup.UserMode = value.Value
 		case "mail_username":
 			emailConfig.Username = value.Value
 		case "mail_password":
@@ -92,26 +98,54 @@ func (client *Client) GetCtfdSetup() (*CtfdSetup, error) {
 	return ctfdSetup, nil
 }
 
+// GetUserMode - Returns the user mode of CTFd, i.e. `UserModeTeams` or `UserModeUsers`
+func (client *Client) GetUserMode(ctx context.Context) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/configs/user_mode", client.HostUrl), nil)
+	if err != nil {
+		return "", err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return "", err
+	}
+
+	config := new(configData)
+	err = json.Unmarshal(*body, &config)
+	if err != nil {
+		return "", err
+	}
+
+	return config.Value, nil
+}
+
 // doSetup - perform the initial setup for CTFd
-func doSetup(client *Client, setup CtfdSetup) error {
-	err := client.setNonce("/setup")
+func doSetup(ctx context.Context, client *Client, setup CtfdSetup) error {
+	client.resetNonce()
+	nonce, err := client.getNonce(ctx, "/setup")
 	if err != nil {
 		return err
 	}
 
+	userMode := setup.UserMode
+	if userMode == "" {
+		userMode = UserModeTeams
+	}
+
 	form := url.Values{}
-	form.Set("nonce", client.Auth.Nonce)
+	form.Set("nonce", nonce)
 	form.Set("ctf_name", setup.Name)
 	form.Set("ctf_description", setup.Description)
 	form.Set("name", client.Auth.Username)
-	form.Set("user_mode", "teams")
+	form.Set("user_mode", userMode)
 	form.Set("email", setup.AdminEmail)
 	form.Set("password", client.Auth.Password)
 
-	res, err := client.HttpClient.PostForm(fmt.Sprintf("%s/setup", client.HostUrl), form)
+	res, err := client.postForm(ctx, fmt.Sprintf("%s/setup", client.HostUrl), form)
 	if err != nil {
 		return err
 	}
+	client.resetNonce()
 	if res.StatusCode != 302 {
 		msg, err := GetErrorFromHtml(*res)
 		if err != nil {
@@ -124,42 +158,25 @@ func doSetup(client *Client, setup CtfdSetup) error {
 }
 
 // importConfiguration - upload challenges file, destroying setup
-func importConfiguration(client *Client, setup CtfdSetup) error {
-	body := new(bytes.Buffer)
-	writer := multipart.NewWriter(body)
-
-	err := client.setNonce("/admin/config")
-	if err != nil {
-		return err
-	}
-	err = writer.WriteField("nonce", client.Auth.Nonce)
-	if err != nil {
-		return err
-	}
-
-	part, err := writer.CreateFormFile("backup", filepath.Base(setup.ConfigurationPath))
+func importConfiguration(ctx context.Context, client *Client, setup CtfdSetup) error {
+	nonce, err := client.getNonce(ctx, "/admin/config")
 	if err != nil {
 		return err
 	}
 
-	file, err := os.Open(setup.ConfigurationPath)
-	if err != nil {
-		return err
+	fields := map[string]string{
+		"nonce": nonce,
 	}
-	_, err = io.Copy(part, file)
-	if err != nil {
-		return err
-	}
-	err = writer.Close()
+	body, contentType, err := newMultipartBody(fields, "backup", setup.ConfigurationPath)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/admin/import", client.HostUrl), body)
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/admin/import", client.HostUrl), body)
 	if err != nil {
 		return err
 	}
-	req.Header.Add("Content-Type", writer.FormDataContentType())
+	req.Header.Add("Content-Type", contentType)
 
 	res, err := client.HttpClient.Do(req)
 	if err != nil {
@@ -178,13 +195,13 @@ func importConfiguration(client *Client, setup CtfdSetup) error {
 }
 
 // setupEmail - configure email services
-func setupEmail(client Client, emailConfig EmailConfig) error {
+func setupEmail(ctx context.Context, client *Client, emailConfig EmailConfig) error {
 	rb, err := json.Marshal(emailConfig)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/configs", client.HostUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/configs", client.HostUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -198,43 +215,47 @@ func setupEmail(client Client, emailConfig EmailConfig) error {
 }
 
 // CreateCtfdSetup - setup a new CTFd instance
-func (client *Client) CreateCtfdSetup(setup CtfdSetup) error {
+func (client *Client) CreateCtfdSetup(ctx context.Context, setup CtfdSetup) error {
+	if client.Auth.Username == "" || client.Auth.Password == "" {
+		return errors.New("username and password are required to setup CTFd")
+	}
+
 	// do initial setup
-	err := doSetup(client, setup)
+	err := doSetup(ctx, client, setup)
 	if err != nil {
 		return err
 	}
 
 	// import configuration file
-	err = importConfiguration(client, setup)
+	err = importConfiguration(ctx, client, setup)
 	if err != nil {
 		return err
 	}
 
 	// repeat initial setup
-	err = doSetup(client, setup)
+	err = doSetup(ctx, client, setup)
 	if err != nil {
 		return err
 	}
 
-	err = client.CheckSetup()
+	err = client.CheckSetup(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = client.SignIn()
+	err = client.SignIn(ctx)
 	if err != nil {
 		return err
 	}
 
-	token, err := client.GetOrCreateToken()
+	token, err := client.GetOrCreateToken(ctx)
 	if err != nil {
 		return err
 	}
-	client.Auth.Token = token.Value
+	client.SetToken(token.Value)
 
 	if setup.Email != nil {
-		err := setupEmail(*client, *setup.Email)
+		err := setupEmail(ctx, client, *setup.Email)
 		if err != nil {
 			return err
 		}
@@ -243,25 +264,56 @@ func (client *Client) CreateCtfdSetup(setup CtfdSetup) error {
 	return nil
 }
 
+// UpdateCtfdSetup - update the name, description and email settings of an
+// existing setup in place, leaving accounts, submissions and challenges intact
+func (client *Client) UpdateCtfdSetup(ctx context.Context, setup CtfdSetup) error {
+	rb, err := json.Marshal(map[string]string{
+		"ctf_name":        setup.Name,
+		"ctf_description": setup.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/configs", client.HostUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	// without an `email` block the mail settings are cleared
+	emailConfig := EmailConfig{}
+	if setup.Email != nil {
+		emailConfig = *setup.Email
+	}
+
+	return setupEmail(ctx, client, emailConfig)
+}
+
 // DeleteCtfdSetup - remove CTFd setup
-func (client *Client) DeleteCtfdSetup() error {
-	err := client.setNonce("/admin/config")
+func (client *Client) DeleteCtfdSetup(ctx context.Context) error {
+	nonce, err := client.getNonce(ctx, "/admin/config")
 	if err != nil {
 		return err
 	}
 
 	form := url.Values{}
-	form.Set("nonce", client.Auth.Nonce)
+	form.Set("nonce", nonce)
 	form.Set("accounts", "y")
 	form.Set("submissions", "y")
 	form.Set("challenges", "y")
 	form.Set("pages", "y")
 	form.Set("notifications", "y")
 
-	res, err := client.HttpClient.PostForm(fmt.Sprintf("%s/admin/reset", client.HostUrl), form)
+	res, err := client.postForm(ctx, fmt.Sprintf("%s/admin/reset", client.HostUrl), form)
 	if err != nil {
 		return err
 	}
+	client.resetNonce()
 	if res.StatusCode != 302 {
 		msg, err := GetErrorFromHtml(*res)
 		if err != nil {
@@ -0,0 +1,68 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestUpdateCtfdSetupInPlace(t *testing.T) {
+	var patches []map[string]interface{}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("PATCH /api/v1/configs", func(w http.ResponseWriter, r *http.Request) {
+		var patch map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&patch); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		patches = append(patches, patch)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"success": true}`)
+	})
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected %s %s", r.Method, r.URL.Path)
+		http.NotFound(w, r)
+	})
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	host, username, password, userAgent := server.URL, "admin", "password", "test"
+	client, err := NewClient(&host, &username, &password, &userAgent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("token")
+
+	setup := CtfdSetup{
+		Name:        "Renamed",
+		Description: "Described",
+	}
+	if err := client.UpdateCtfdSetup(context.Background(), setup); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []map[string]interface{}{
+		{
+			"ctf_name":        "Renamed",
+			"ctf_description": "Described",
+		},
+		{
+			"mail_username": "",
+			"mail_password": "",
+			"mailfrom_addr": "",
+			"mail_server":   "",
+			"mail_port":     float64(0),
+			"mail_useauth":  false,
+			"mail_tls":      false,
+			"mail_ssl":      false,
+		},
+	}
+	if !reflect.DeepEqual(patches, want) {
+		t.Errorf("got %#v; want %#v", patches, want)
+	}
+}
@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// NewDynamicChallenge - fields required when creating a new dynamic challenge
+type NewDynamicChallenge struct {
+	NewChallenge
+	Initial  int    `json:"initial"`
+	Decay    int    `json:"decay"`
+	Minimum  int    `json:"minimum"`
+	Function string `json:"function"`
+}
+
+// DynamicChallenge - fields as returned from the CTFd API
+type DynamicChallenge struct {
+	Challenge
+	Initial  int    `json:"initial"`
+	Decay    int    `json:"decay"`
+	Minimum  int    `json:"minimum"`
+	Function string `json:"function"`
+}
+
+// GetDynamicChallenge - Returns details of a dynamic challenge
+func (client *Client) GetDynamicChallenge(ctx context.Context, id uint) (*DynamicChallenge, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/challenges/%d", client.HostUrl, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	challenge := new(DynamicChallenge)
+	err = json.Unmarshal(*body, &challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return challenge, nil
+}
+
+// CreateDynamicChallenge - create a new dynamic challenge
+func (client *Client) CreateDynamicChallenge(ctx context.Context, challenge NewDynamicChallenge) (*DynamicChallenge, error) {
+	challenge.Type = "dynamic"
+	rb, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/challenges", client.HostUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newChallenge := new(DynamicChallenge)
+	err = json.Unmarshal(*body, &newChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return newChallenge, nil
+}
+
+// UpdateDynamicChallenge - updated an existing dynamic challenge
+func (client *Client) UpdateDynamicChallenge(ctx context.Context, id uint, challenge NewDynamicChallenge) (*DynamicChallenge, error) {
+	challenge.Type = "dynamic"
+	rb, err := json.Marshal(challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/challenges/%d", client.HostUrl, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedChallenge := new(DynamicChallenge)
+	err = json.Unmarshal(*body, &updatedChallenge)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedChallenge, nil
+}
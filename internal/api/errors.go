@@ -0,0 +1,64 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// StatusError - an unsuccessful response from the CTFd API
+type StatusError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *StatusError) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("status: %d", e.StatusCode)
+	}
+	return fmt.Sprintf("status: %d, message: %s", e.StatusCode, e.Message)
+}
+
+// UnauthorizedError - 401; the client is not authenticated
+type UnauthorizedError struct{ StatusError }
+
+// ForbiddenError - 403; the client lacks permission
+type ForbiddenError struct{ StatusError }
+
+// NotFoundError - 404; the object does not exist
+type NotFoundError struct{ StatusError }
+
+// ConflictError - 409; the object conflicts with an existing one
+type ConflictError struct{ StatusError }
+
+// UnprocessableEntityError - 422; the request was rejected by validation
+type UnprocessableEntityError struct{ StatusError }
+
+// newStatusError - the typed error for a given status code
+func newStatusError(statusCode int, message string) error {
+	statusError := StatusError{
+		StatusCode: statusCode,
+		Message:    message,
+	}
+
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return &UnauthorizedError{statusError}
+	case http.StatusForbidden:
+		return &ForbiddenError{statusError}
+	case http.StatusNotFound:
+		return &NotFoundError{statusError}
+	case http.StatusConflict:
+		return &ConflictError{statusError}
+	case http.StatusUnprocessableEntity:
+		return &UnprocessableEntityError{statusError}
+	}
+
+	return &statusError
+}
+
+// IsNotFound - whether err indicates the requested object does not exist
+func IsNotFound(err error) bool {
+	var notFound *NotFoundError
+	return errors.As(err, &notFound)
+}
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// File - fields as returned from the CTFd API
+type File struct {
+	Id       uint   `json:"id"`
+	Type     string `json:"type"`
+	Location string `json:"location"`
+	Sha1sum  string `json:"sha1sum"`
+}
+
+// GetFile - Returns details of a file
+func (client *Client) GetFile(ctx context.Context, id uint) (*File, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/files/%d", client.HostUrl, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	file := new(File)
+	err = json.Unmarshal(*body, &file)
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// CreateChallengeFile - upload a file and attach it to a challenge
+func (client *Client) CreateChallengeFile(ctx context.Context, challengeId uint, path string) (*File, error) {
+	fields := map[string]string{
+		"challenge": strconv.Itoa(int(challengeId)),
+		"type":      "challenge",
+	}
+	rb, contentType, err := newMultipartBody(fields, "file", path)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/files", client.HostUrl), rb)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make([]File, 0)
+	err = json.Unmarshal(*body, &files)
+	if err != nil {
+		return nil, err
+	}
+	if len(files) != 1 {
+		return nil, errors.New("unexpected number of files returned from upload")
+	}
+
+	return &files[0], nil
+}
+
+// DeleteFile - remove an existing file
+func (client *Client) DeleteFile(ctx context.Context, id uint) error {
+	emptyRequest := []byte("{}")
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/files/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
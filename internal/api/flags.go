@@ -0,0 +1,116 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// NewFlag - fields required when creating a new flag
+type NewFlag struct {
+	ChallengeId uint   `json:"challenge_id"`
+	Type        string `json:"type"`
+	Content     string `json:"content"`
+	Data        string `json:"data"`
+}
+
+// Flag - fields as returned from the CTFd API
+type Flag struct {
+	Id          uint   `json:"id"`
+	ChallengeId uint   `json:"challenge_id"`
+	Type        string `json:"type"`
+	Content     string `json:"content"`
+	Data        string `json:"data"`
+}
+
+// GetFlag - Returns details of a flag
+func (client *Client) GetFlag(ctx context.Context, id uint) (*Flag, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/flags/%d", client.HostUrl, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	flag := new(Flag)
+	err = json.Unmarshal(*body, &flag)
+	if err != nil {
+		return nil, err
+	}
+
+	return flag, nil
+}
+
+// CreateFlag - create a new flag
+func (client *Client) CreateFlag(ctx context.Context, flag NewFlag) (*Flag, error) {
+	rb, err := json.Marshal(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/flags", client.HostUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newFlag := new(Flag)
+	err = json.Unmarshal(*body, &newFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFlag, nil
+}
+
+// UpdateFlag - updated an existing flag
+func (client *Client) UpdateFlag(ctx context.Context, id uint, flag NewFlag) (*Flag, error) {
+	rb, err := json.Marshal(flag)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/flags/%d", client.HostUrl, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedFlag := new(Flag)
+	err = json.Unmarshal(*body, &updatedFlag)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedFlag, nil
+}
+
+// DeleteFlag - remove an existing flag
+func (client *Client) DeleteFlag(ctx context.Context, id uint) error {
+	emptyRequest := []byte("{}")
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/flags/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// HintRequirements - hints which must be unlocked before this one
+type HintRequirements struct {
+	Prerequisites []uint `json:"prerequisites"`
+}
+
+// NewHint - fields required when creating a new hint
+type NewHint struct {
+	ChallengeId  uint              `json:"challenge_id"`
+	Title        string            `json:"title"`
+	Content      string            `json:"content"`
+	Cost         int               `json:"cost"`
+	Requirements *HintRequirements `json:"requirements"`
+}
+
+// Hint - fields as returned from the CTFd API
+type Hint struct {
+	Id           uint              `json:"id"`
+	ChallengeId  uint              `json:"challenge_id"`
+	Type         string            `json:"type"`
+	Title        string            `json:"title"`
+	Content      string            `json:"content"`
+	Cost         int               `json:"cost"`
+	Requirements *HintRequirements `json:"requirements"`
+}
+
+// GetHint - Returns details of a hint; `preview` requests the admin view, as
+// otherwise hints with a cost are returned locked, without `content`, and
+// `requirements` is never returned
+func (client *Client) GetHint(ctx context.Context, id uint) (*Hint, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/hints/%d?preview=true", client.HostUrl, id), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	hint := new(Hint)
+	err = json.Unmarshal(*body, &hint)
+	if err != nil {
+		return nil, err
+	}
+
+	return hint, nil
+}
+
+// CreateHint - create a new hint
+func (client *Client) CreateHint(ctx context.Context, hint NewHint) (*Hint, error) {
+	rb, err := json.Marshal(hint)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/hints", client.HostUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newHint := new(Hint)
+	err = json.Unmarshal(*body, &newHint)
+	if err != nil {
+		return nil, err
+	}
+
+	return newHint, nil
+}
+
+// UpdateHint - updated an existing hint
+func (client *Client) UpdateHint(ctx context.Context, id uint, hint NewHint) (*Hint, error) {
+	rb, err := json.Marshal(hint)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/hints/%d", client.HostUrl, id), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	updatedHint := new(Hint)
+	err = json.Unmarshal(*body, &updatedHint)
+	if err != nil {
+		return nil, err
+	}
+
+	return updatedHint, nil
+}
+
+// DeleteHint - remove an existing hint
+func (client *Client) DeleteHint(ctx context.Context, id uint) error {
+	emptyRequest := []byte("{}")
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/hints/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetHintPreview(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// without `preview` CTFd returns the locked view of a hint with a cost
+		if r.URL.Query().Get("preview") != "true" {
+			fmt.Fprint(w, `{"success": true, "data": {"id": 2, "type": "standard", "challenge": 1, "cost": 10, "view": "locked"}}`)
+			return
+		}
+		fmt.Fprint(w, `{"success": true, "data": {"id": 2, "type": "standard", "challenge_id": 1, "title": "Look closer", "content": "It's in the headers", "cost": 10, "requirements": {"prerequisites": [1]}}}`)
+	}))
+	defer server.Close()
+
+	host, username, password, userAgent := server.URL, "admin", "password", "test"
+	client, err := NewClient(&host, &username, &password, &userAgent)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("token")
+
+	hint, err := client.GetHint(context.Background(), 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &Hint{
+		Id:          2,
+		ChallengeId: 1,
+		Type:        "standard",
+		Title:       "Look closer",
+		Content:     "It's in the headers",
+		Cost:        10,
+		Requirements: &HintRequirements{
+			Prerequisites: []uint{1},
+		},
+	}
+	if !reflect.DeepEqual(hint, want) {
+		t.Errorf("got %#v; want %#v", hint, want)
+	}
+}
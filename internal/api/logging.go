@@ -0,0 +1,249 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const redacted = "REDACTED"
+
+// sensitiveHeaders - never logged verbatim
+var sensitiveHeaders = []string{
+	"Authorization",
+	"CSRF-Token",
+	"Cookie",
+	"Set-Cookie",
+}
+
+// sensitiveFields - JSON and form fields never logged verbatim, regardless of path
+var sensitiveFields = map[string]bool{
+	"password":            true,
+	"mail_password":       true,
+	"mailgun_api_key":     true,
+	"oauth_client_secret": true,
+	"registration_code":   true,
+	"nonce":               true,
+	"secret":              true,
+	"token":               true,
+}
+
+// sensitivePathFields - fields only sensitive beneath a given API path, e.g.
+// `content` is a secret for flags but not for hints
+var sensitivePathFields = map[string][]string{
+	"/api/v1/flags":  {"content"},
+	"/api/v1/tokens": {"value"},
+}
+
+// traceEnvVars - environment variables setting Terraform's log level for this
+// provider, most specific first
+var traceEnvVars = []string{
+	"TF_LOG_PROVIDER_CTFD",
+	"TF_LOG_PROVIDER",
+	"TF_LOG",
+}
+
+// traceEnabled - whether Terraform will keep TRACE logs from the provider;
+// `tflog` always logs at TRACE and leaves filtering to Terraform, so this
+// mirrors the environment variables Terraform filters on
+func traceEnabled() bool {
+	for _, name := range traceEnvVars {
+		level := os.Getenv(name)
+		if level == "" {
+			continue
+		}
+
+		return strings.EqualFold(level, "TRACE") || strings.EqualFold(level, "JSON")
+	}
+
+	return false
+}
+
+// loggingTransport - logs each request to CTFd via `tflog`, with bodies at TRACE
+type loggingTransport struct {
+	next http.RoundTripper
+
+	// trace - whether to log headers and bodies, which means buffering and
+	// redacting each body, so is only done if the logs would be kept
+	trace bool
+}
+
+func newLoggingTransport(next http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		next:  next,
+		trace: traceEnabled(),
+	}
+}
+
+func (transport *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"path":   req.URL.Path,
+	}
+
+	if transport.trace {
+		tflog.Trace(ctx, "CTFd request", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"query":   req.URL.RawQuery,
+			"headers": redactHeaders(req.Header),
+			"body":    requestBody(req),
+		})
+	}
+
+	start := time.Now()
+	res, err := transport.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "CTFd request failed", fields)
+		return res, err
+	}
+	fields["status"] = res.StatusCode
+	tflog.Debug(ctx, "CTFd request complete", fields)
+
+	if transport.trace {
+		tflog.Trace(ctx, "CTFd response", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"status":  res.StatusCode,
+			"headers": redactHeaders(res.Header),
+			"body":    responseBody(req.URL.Path, res),
+		})
+	}
+
+	return res, nil
+}
+
+// redactHeaders - a copy of `header` with credentials removed
+func redactHeaders(header http.Header) map[string]string {
+	headers := map[string]string{}
+	for key := range header {
+		headers[key] = header.Get(key)
+	}
+	for _, key := range sensitiveHeaders {
+		if header.Get(key) != "" {
+			headers[http.CanonicalHeaderKey(key)] = redacted
+		}
+	}
+
+	return headers
+}
+
+// requestBody - the redacted body of `req`, leaving the body itself unread
+func requestBody(req *http.Request) string {
+	if req.Body == nil || req.GetBody == nil {
+		return ""
+	}
+	if strings.HasPrefix(req.Header.Get("Content-Type"), "multipart/") {
+		return "(multipart body omitted)"
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return ""
+	}
+	defer body.Close()
+	contents, err := io.ReadAll(body)
+	if err != nil {
+		return ""
+	}
+
+	return redactBody(req.URL.Path, req.Header.Get("Content-Type"), contents)
+}
+
+// responseBody - the redacted body of `res`, which is replaced so that it
+// may still be read by the caller
+func responseBody(path string, res *http.Response) string {
+	if res.Body == nil {
+		return ""
+	}
+
+	contents, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	res.Body = io.NopCloser(bytes.NewReader(contents))
+	if err != nil {
+		return ""
+	}
+
+	return redactBody(path, res.Header.Get("Content-Type"), contents)
+}
+
+// redactBody - `contents` with sensitive JSON or form fields replaced; other
+// bodies, e.g. HTML pages embedding the CSRF nonce, are omitted
+func redactBody(path string, contentType string, contents []byte) string {
+	fields := map[string]bool{}
+	for key := range sensitiveFields {
+		fields[key] = true
+	}
+	for prefix, keys := range sensitivePathFields {
+		if strings.HasPrefix(path, prefix) {
+			for _, key := range keys {
+				fields[key] = true
+			}
+		}
+	}
+
+	switch {
+	case strings.HasPrefix(contentType, "application/json"):
+		var value interface{}
+		if err := json.Unmarshal(contents, &value); err != nil {
+			return ""
+		}
+		redacted, err := json.Marshal(redactValue(value, fields))
+		if err != nil {
+			return ""
+		}
+		return string(redacted)
+	case strings.HasPrefix(contentType, "application/x-www-form-urlencoded"):
+		form, err := url.ParseQuery(string(contents))
+		if err != nil {
+			return ""
+		}
+		for key := range form {
+			if fields[key] {
+				form.Set(key, redacted)
+			}
+		}
+		return form.Encode()
+	}
+
+	return fmt.Sprintf("(%d byte %s body omitted)", len(contents), contentType)
+}
+
+// redactValue - recursively replace the values of `fields` in decoded JSON,
+// including the `value` of `{"key": ..., "value": ...}` pairs, as returned for
+// each setting by `/api/v1/configs`, whose `key` is one of `fields`
+func redactValue(value interface{}, fields map[string]bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if key, ok := v["key"].(string); ok && fields[key] {
+			if _, ok := v["value"]; ok {
+				v["value"] = redacted
+			}
+		}
+		for key, item := range v {
+			if fields[key] {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactValue(item, fields)
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactValue(item, fields)
+		}
+	}
+
+	return value
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeaders(t *testing.T) {
+	header := http.Header{}
+	header.Set("Authorization", "Token ctfd_secret")
+	header.Set("Csrf-Token", "nonce")
+	header.Set("Cookie", "session=abc")
+	header.Set("Set-Cookie", "session=def")
+	header.Set("Content-Type", "application/json")
+
+	want := map[string]string{
+		"Authorization": redacted,
+		"Csrf-Token":    redacted,
+		"Cookie":        redacted,
+		"Set-Cookie":    redacted,
+		"Content-Type":  "application/json",
+	}
+
+	got := redactHeaders(header)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %#v; want %#v", got, want)
+	}
+	if header.Get("Authorization") != "Token ctfd_secret" {
+		t.Error("original headers were modified")
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	cases := []struct {
+		name        string
+		path        string
+		contentType string
+		body        string
+		want        string
+	}{
+		{
+			name:        "configs list",
+			path:        "/api/v1/configs",
+			contentType: "application/json",
+			body:        `{"success": true, "data": [{"id": 1, "key": "ctf_name", "value": "Example CTF"}, {"id": 2, "key": "mail_password", "value": "hunter2"}, {"id": 3, "key": "oauth_client_secret", "value": "shh"}]}`,
+			want:        `{"data":[{"id":1,"key":"ctf_name","value":"Example CTF"},{"id":2,"key":"mail_password","value":"REDACTED"},{"id":3,"key":"oauth_client_secret","value":"REDACTED"}],"success":true}`,
+		},
+		{
+			name:        "single config",
+			path:        "/api/v1/configs/mail_password",
+			contentType: "application/json",
+			body:        `{"success": true, "data": {"id": 2, "key": "mail_password", "value": "hunter2"}}`,
+			want:        `{"data":{"id":2,"key":"mail_password","value":"REDACTED"},"success":true}`,
+		},
+		{
+			name:        "configs patch",
+			path:        "/api/v1/configs",
+			contentType: "application/json",
+			body:        `{"mail_server": "smtp.example.com", "mail_password": "hunter2"}`,
+			want:        `{"mail_password":"REDACTED","mail_server":"smtp.example.com"}`,
+		},
+		{
+			name:        "nested fields",
+			path:        "/api/v1/users/1",
+			contentType: "application/json",
+			body:        `{"success": true, "data": {"name": "alice", "password": "hunter2", "secret": "shh"}}`,
+			want:        `{"data":{"name":"alice","password":"REDACTED","secret":"REDACTED"},"success":true}`,
+		},
+		{
+			name:        "flag content",
+			path:        "/api/v1/flags/1",
+			contentType: "application/json",
+			body:        `{"content": "CTF{flag}", "type": "static"}`,
+			want:        `{"content":"REDACTED","type":"static"}`,
+		},
+		{
+			name:        "hint content",
+			path:        "/api/v1/hints/1",
+			contentType: "application/json",
+			body:        `{"content": "Look closer", "cost": 10}`,
+			want:        `{"content":"Look closer","cost":10}`,
+		},
+		{
+			name:        "token value",
+			path:        "/api/v1/tokens",
+			contentType: "application/json",
+			body:        `{"success": true, "data": {"id": 1, "value": "ctfd_secret"}}`,
+			want:        `{"data":{"id":1,"value":"REDACTED"},"success":true}`,
+		},
+		{
+			name:        "form",
+			path:        "/login",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "name=admin&password=hunter2&nonce=abc",
+			want:        "name=admin&nonce=REDACTED&password=REDACTED",
+		},
+		{
+			name:        "html",
+			path:        "/settings",
+			contentType: "text/html; charset=utf-8",
+			body:        "<script>var init = {'csrfNonce': \"abc\"}</script>",
+			want:        "(48 byte text/html; charset=utf-8 body omitted)",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := redactBody(c.path, c.contentType, []byte(c.body))
+			if got != c.want {
+				t.Errorf("got %s; want %s", got, c.want)
+			}
+		})
+	}
+}
+
+func TestResponseBodyLeavesBodyReadable(t *testing.T) {
+	body := `{"success": true, "data": [{"key": "mail_password", "value": "hunter2"}]}`
+	res := &http.Response{
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+		Body:   io.NopCloser(strings.NewReader(body)),
+	}
+
+	logged := responseBody("/api/v1/configs", res)
+	if strings.Contains(logged, "hunter2") {
+		t.Errorf("logged body not redacted: %s", logged)
+	}
+
+	contents, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(contents, []byte(body)) {
+		t.Errorf("got body %s; want %s", contents, body)
+	}
+}
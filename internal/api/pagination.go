@@ -0,0 +1,57 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// ListOptions - settings for requests to the paginated list endpoints
+type ListOptions struct {
+	// PerPage - results requested per page; zero leaves CTFd's default of 50
+	PerPage uint
+
+	// Filters - query parameters the endpoint filters on, e.g. `affiliation`
+	Filters map[string]string
+}
+
+// getAllPages - GET each page of the list at `path` in turn, passing the data
+// of every page to `page` until CTFd reports there is no next page
+func (client *Client) getAllPages(ctx context.Context, path string, options ListOptions, page func(*json.RawMessage) error) error {
+	query := url.Values{}
+	if options.PerPage > 0 {
+		query.Set("per_page", strconv.Itoa(int(options.PerPage)))
+	}
+	for key, value := range options.Filters {
+		query.Set(key, value)
+	}
+
+	current := uint(1)
+	for {
+		query.Set("page", strconv.Itoa(int(current)))
+		req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s%s?%s", client.HostUrl, path, query.Encode()), nil)
+		if err != nil {
+			return err
+		}
+
+		body, meta, err := client.DoApiRequestWithMeta(req)
+		if err != nil {
+			return err
+		}
+		if body != nil {
+			err = page(body)
+			if err != nil {
+				return err
+			}
+		}
+
+		// endpoints which aren't paginated, e.g. challenges, return no `next`
+		if meta == nil || meta.Pagination.Next == nil || *meta.Pagination.Next <= current {
+			return nil
+		}
+		current = *meta.Pagination.Next
+	}
+}
@@ -0,0 +1,92 @@
+package api
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// RateLimiter - token bucket limiting requests per second, with an optional
+// bound on the number of requests in flight at once
+type RateLimiter struct {
+	mu        sync.Mutex
+	rate      float64
+	burst     float64
+	tokens    float64
+	last      time.Time
+	semaphore chan struct{}
+}
+
+// NewRateLimiter - `requestsPerSecond` of zero disables rate limiting and
+// `maxConcurrent` of zero disables the bound on concurrency
+func NewRateLimiter(requestsPerSecond float64, maxConcurrent int) *RateLimiter {
+	burst := requestsPerSecond
+	if burst < 1 {
+		burst = 1
+	}
+
+	limiter := &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+	if maxConcurrent > 0 {
+		limiter.semaphore = make(chan struct{}, maxConcurrent)
+	}
+
+	return limiter
+}
+
+// reserve - take a token, returning how long to wait before it may be used
+func (limiter *RateLimiter) reserve() time.Duration {
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	now := time.Now()
+	limiter.tokens += now.Sub(limiter.last).Seconds() * limiter.rate
+	if limiter.tokens > limiter.burst {
+		limiter.tokens = limiter.burst
+	}
+	limiter.last = now
+
+	limiter.tokens--
+	if limiter.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-limiter.tokens / limiter.rate * float64(time.Second))
+}
+
+// Acquire - block until a request may be sent; the returned function must be
+// called once the request has completed
+func (limiter *RateLimiter) Acquire(ctx context.Context) (func(), error) {
+	if limiter == nil {
+		return func() {}, nil
+	}
+
+	release := func() {}
+	if limiter.semaphore != nil {
+		select {
+		case limiter.semaphore <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		release = func() { <-limiter.semaphore }
+	}
+
+	if limiter.rate > 0 {
+		if wait := limiter.reserve(); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				release()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	return release, nil
+}
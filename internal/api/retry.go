@@ -0,0 +1,124 @@
+package api
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// RetryPolicy - how transient failures are retried
+type RetryPolicy struct {
+	MaxRetries int
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy - used unless the provider configures otherwise
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries: 3,
+	MinBackoff: 1 * time.Second,
+	MaxBackoff: 30 * time.Second,
+}
+
+// isIdempotent - whether a request may safely be sent more than once
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case "GET", "HEAD", "OPTIONS", "PUT", "DELETE":
+		return true
+	}
+
+	return false
+}
+
+// isRetryable - whether a status code indicates a transient failure
+func isRetryable(statusCode int) bool {
+	switch statusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
+
+// backoff - delay before the given retry attempt; a `Retry-After` is waited
+// in full, as retrying sooner would only be refused again, leaving the
+// request's context to bound it
+func (policy RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if retryAfter := res.Header.Get("Retry-After"); retryAfter != "" {
+			if seconds, err := strconv.Atoi(retryAfter); err == nil {
+				return max(time.Duration(seconds)*time.Second, 0)
+			}
+			if date, err := http.ParseTime(retryAfter); err == nil {
+				return max(time.Until(date), 0)
+			}
+		}
+	}
+
+	wait := policy.MinBackoff
+	for i := 0; i < attempt && wait < policy.MaxBackoff; i++ {
+		wait *= 2
+	}
+
+	return policy.clamp(wait)
+}
+
+func (policy RetryPolicy) clamp(wait time.Duration) time.Duration {
+	if wait < policy.MinBackoff {
+		return policy.MinBackoff
+	}
+	if wait > policy.MaxBackoff {
+		return policy.MaxBackoff
+	}
+
+	return wait
+}
+
+// shouldRetry - whether a failed attempt may be repeated; requests which are
+// not idempotent are only retried when rate-limited, as they were not processed
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if isIdempotent(req) {
+		return err != nil || isRetryable(res.StatusCode)
+	}
+
+	return err == nil && res.StatusCode == http.StatusTooManyRequests
+}
+
+// doWithRetry - send a request, retrying on transient failure
+func (client *Client) doWithRetry(req *http.Request) (*http.Response, error) {
+	policy := client.RetryPolicy
+
+	for attempt := 0; ; attempt++ {
+		release, err := client.RateLimiter.Acquire(req.Context())
+		if err != nil {
+			return nil, err
+		}
+		res, err := client.HttpClient.Do(req)
+		release()
+		if attempt >= policy.MaxRetries || !shouldRetry(req, res, err) {
+			return res, err
+		}
+
+		wait := policy.backoff(attempt, res)
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+
+		if req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
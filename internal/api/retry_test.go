@@ -0,0 +1,51 @@
+package api
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: 1 * time.Second,
+		MaxBackoff: 30 * time.Second,
+	}
+
+	retryAfter := func(value string) *http.Response {
+		return &http.Response{
+			StatusCode: http.StatusTooManyRequests,
+			Header:     http.Header{"Retry-After": []string{value}},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		attempt int
+		res     *http.Response
+		want    time.Duration
+	}{
+		{"first attempt", 0, nil, 1 * time.Second},
+		{"doubles", 2, nil, 4 * time.Second},
+		{"capped", 10, nil, 30 * time.Second},
+		{"retry after seconds", 0, retryAfter("5"), 5 * time.Second},
+		{"retry after beyond max backoff", 0, retryAfter("120"), 120 * time.Second},
+		{"retry after zero", 0, retryAfter("0"), 0},
+		{"retry after in the past", 0, retryAfter("Mon, 02 Jan 2006 15:04:05 GMT"), 0},
+		{"retry after unparseable", 1, retryAfter("soon"), 2 * time.Second},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			if got := policy.backoff(c.attempt, c.res); got != c.want {
+				t.Errorf("got %s; want %s", got, c.want)
+			}
+		})
+	}
+
+	date := time.Now().Add(2 * time.Minute).UTC().Format(http.TimeFormat)
+	if got := policy.backoff(0, retryAfter(date)); got < 110*time.Second || got > 120*time.Second {
+		t.Errorf("Retry-After %s: got %s; want about 2m", date, got)
+	}
+}
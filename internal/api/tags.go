@@ -0,0 +1,84 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Tag - fields as returned from the CTFd API
+type Tag struct {
+	Id          uint   `json:"id"`
+	ChallengeId uint   `json:"challenge_id"`
+	Value       string `json:"value"`
+}
+
+// GetChallengeTags - Returns the tags of a challenge
+func (client *Client) GetChallengeTags(ctx context.Context, challengeId uint) (*[]Tag, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/challenges/%d/tags", client.HostUrl, challengeId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := new([]Tag)
+	err = json.Unmarshal(*body, &tags)
+	if err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// CreateTag - add a tag to a challenge
+func (client *Client) CreateTag(ctx context.Context, challengeId uint, value string) (*Tag, error) {
+	tag := map[string]interface{}{
+		"challenge_id": challengeId,
+		"value":        value,
+	}
+	rb, err := json.Marshal(tag)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/tags", client.HostUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newTag := new(Tag)
+	err = json.Unmarshal(*body, &newTag)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTag, nil
+}
+
+// DeleteTag - remove an existing tag
+func (client *Client) DeleteTag(ctx context.Context, id uint) error {
+	emptyRequest := []byte("{}")
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/tags/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
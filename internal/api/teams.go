@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -20,38 +21,37 @@ type NewTeam struct {
 }
 
 type Team struct {
-	Name        string   `json:"name"`
-	Email       string   `json:"email"`
-	Password    string   `json:"password"`
-	Website     string   `json:"website"`
-	Affiliation string   `json:"affiliation"`
-	Country     string   `json:"country"`
-	Hidden      bool     `json:"hidden"`
-	Banned      bool     `json:"banned"`
-	CaptainId   *uint    `json:"captain_id"`
-	Bracket     string   `json:"bracket"`
-	Id          uint     `json:"id"`
-	Secret      string   `json:"secret"`
-	OauthId     string   `json:"oauth_id"`
-	Members     []uint   `json:"members"`
-	Created     string   `json:"created"`
-	Fields      []string `json:"fields"`
+	Name        string       `json:"name"`
+	Email       string       `json:"email"`
+	Password    string       `json:"password"`
+	Website     string       `json:"website"`
+	Affiliation string       `json:"affiliation"`
+	Country     string       `json:"country"`
+	Hidden      bool         `json:"hidden"`
+	Banned      bool         `json:"banned"`
+	CaptainId   *uint        `json:"captain_id"`
+	Bracket     string       `json:"bracket"`
+	Id          uint         `json:"id"`
+	Secret      string       `json:"secret"`
+	OauthId     *uint        `json:"oauth_id"`
+	Members     []uint       `json:"members"`
+	Created     string       `json:"created"`
+	Fields      []FieldEntry `json:"fields"`
 }
 
-// GetTeams - Returns list of teams
-func (client *Client) GetTeams() (interface{}, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/teams", client.HostUrl), nil)
-	if err != nil {
-		return nil, err
-	}
-
-	body, err := client.DoApiRequest(req)
-	if err != nil {
-		return nil, err
-	}
+// GetTeams - Returns list of teams, across all pages
+func (client *Client) GetTeams(ctx context.Context, options ListOptions) ([]Team, error) {
+	teams := make([]Team, 0)
+	err := client.getAllPages(ctx, "/api/v1/teams", options, func(body *json.RawMessage) error {
+		page := make([]Team, 0)
+		err := json.Unmarshal(*body, &page)
+		if err != nil {
+			return err
+		}
+		teams = append(teams, page...)
 
-	teams := make([]map[string]interface{}, 0)
-	err = json.Unmarshal(*body, &teams)
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -60,8 +60,8 @@ func (client *Client) GetTeams() (interface{}, error) {
 }
 
 // GetTeam - Returns details of a team
-func (client *Client) GetTeam(id uint) (*Team, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/teams/%d", client.HostUrl, id), nil)
+func (client *Client) GetTeam(ctx context.Context, id uint) (*Team, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/teams/%d", client.HostUrl, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,8 +81,8 @@ func (client *Client) GetTeam(id uint) (*Team, error) {
 }
 
 // GetTeamMemberships - Returns memberships of a team
-func (client *Client) GetTeamMemberships(id uint) (*[]uint, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/teams/%d/members", client.HostUrl, id), nil)
+func (client *Client) GetTeamMemberships(ctx context.Context, id uint) (*[]uint, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/teams/%d/members", client.HostUrl, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -102,13 +102,13 @@ func (client *Client) GetTeamMemberships(id uint) (*[]uint, error) {
 }
 
 // CreateTeam - create a new team
-func (client *Client) CreateTeam(team NewTeam) (*Team, error) {
+func (client *Client) CreateTeam(ctx context.Context, team NewTeam) (*Team, error) {
 	rb, err := json.Marshal(team)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/teams", client.HostUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/teams", client.HostUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -128,13 +128,13 @@ func (client *Client) CreateTeam(team NewTeam) (*Team, error) {
 }
 
 // UpdateTeam - updated an existing team
-func (client *Client) UpdateTeam(id uint, team NewTeam) (*Team, error) {
+func (client *Client) UpdateTeam(ctx context.Context, id uint, team NewTeam) (*Team, error) {
 	rb, err := json.Marshal(team)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/teams/%d", client.HostUrl, id), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/teams/%d", client.HostUrl, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -154,9 +154,9 @@ func (client *Client) UpdateTeam(id uint, team NewTeam) (*Team, error) {
 }
 
 // DeleteTeam - remove an existing team
-func (client *Client) DeleteTeam(id uint) error {
+func (client *Client) DeleteTeam(ctx context.Context, id uint) error {
 	emptyRequest := []byte("{}")
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/teams/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/teams/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// a page of `GET /api/v1/teams?view=admin` as returned by CTFd
+const teamsPage = `[
+	{
+		"id": 3,
+		"oauth_id": 1234,
+		"name": "Red Team",
+		"email": "red@example.com",
+		"website": null,
+		"affiliation": null,
+		"country": null,
+		"bracket": null,
+		"hidden": false,
+		"banned": false,
+		"captain_id": 7,
+		"created": "2024-01-01T00:00:00+00:00",
+		"secret": null,
+		"members": [7, 8],
+		"place": null,
+		"score": 0,
+		"fields": [
+			{"field_id": 1, "name": "Shirt size", "description": "", "type": "text", "value": "L"},
+			{"field_id": 2, "name": "Students", "description": "All members are students", "type": "boolean", "value": true}
+		]
+	},
+	{
+		"id": 4,
+		"oauth_id": null,
+		"name": "Blue Team",
+		"email": null,
+		"website": null,
+		"affiliation": null,
+		"country": null,
+		"bracket": null,
+		"hidden": true,
+		"banned": false,
+		"captain_id": null,
+		"created": "2024-01-02T00:00:00+00:00",
+		"secret": null,
+		"members": [],
+		"place": null,
+		"score": 0,
+		"fields": []
+	}
+]`
+
+func TestTeamUnmarshalJSON(t *testing.T) {
+	oauthId, captainId := uint(1234), uint(7)
+	want := []Team{
+		{
+			Id:        3,
+			OauthId:   &oauthId,
+			Name:      "Red Team",
+			Email:     "red@example.com",
+			CaptainId: &captainId,
+			Created:   "2024-01-01T00:00:00+00:00",
+			Members:   []uint{7, 8},
+			Fields: []FieldEntry{
+				{FieldId: 1, Name: "Shirt size", Type: "text", Value: "L"},
+				{FieldId: 2, Name: "Students", Description: "All members are students", Type: "boolean", Value: "true"},
+			},
+		},
+		{
+			Id:      4,
+			Name:    "Blue Team",
+			Hidden:  true,
+			Created: "2024-01-02T00:00:00+00:00",
+			Members: []uint{},
+			Fields:  []FieldEntry{},
+		},
+	}
+
+	var teams []Team
+	if err := json.Unmarshal([]byte(teamsPage), &teams); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(teams, want) {
+		t.Errorf("got %#v; want %#v", teams, want)
+	}
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -17,17 +18,17 @@ type Token struct {
 }
 
 // GetTokens - get token objects in bulk
-func (client *Client) GetTokens() (*[]Token, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/tokens", client.HostUrl), nil)
+func (client *Client) GetTokens(ctx context.Context) (*[]Token, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/tokens", client.HostUrl), nil)
 	if err != nil {
 		return nil, err
 	}
 
-	err = client.setNonce("/settings")
+	nonce, err := client.getNonce(ctx, "/settings")
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("CSRF-Token", client.Auth.Nonce)
+	req.Header.Set("CSRF-Token", nonce)
 	req.Header.Set("Content-Type", "application/json")
 
 	res, err := client.HttpClient.Do(req)
@@ -55,14 +56,14 @@ func (client *Client) GetTokens() (*[]Token, error) {
 }
 
 // GetOrCreateToken - re-use or create a token object
-func (client *Client) GetOrCreateToken() (*Token, error) {
-	tokens, err := client.GetTokens()
+func (client *Client) GetOrCreateToken(ctx context.Context) (*Token, error) {
+	tokens, err := client.GetTokens(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	for _, partialToken := range *tokens {
-		res, err := client.HttpClient.Get(fmt.Sprintf("%s/api/v1/tokens/%d", client.HostUrl, partialToken.Id))
+		res, err := client.get(ctx, fmt.Sprintf("%s/api/v1/tokens/%d", client.HostUrl, partialToken.Id))
 		if err != nil {
 			return nil, err
 		}
@@ -87,7 +88,7 @@ func (client *Client) GetOrCreateToken() (*Token, error) {
 		}
 	}
 
-	token, err := client.CreateToken()
+	token, err := client.CreateToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -96,18 +97,18 @@ func (client *Client) GetOrCreateToken() (*Token, error) {
 }
 
 // CreateToken - create a token object
-func (client *Client) CreateToken() (newToken *Token, err error) {
+func (client *Client) CreateToken(ctx context.Context) (newToken *Token, err error) {
 	emptyRequest := []byte("{}")
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/tokens", client.HostUrl), bytes.NewBuffer(emptyRequest))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/tokens", client.HostUrl), bytes.NewBuffer(emptyRequest))
 	if err != nil {
 		return nil, err
 	}
 
-	err = client.setNonce("/settings")
+	nonce, err := client.getNonce(ctx, "/settings")
 	if err != nil {
 		return nil, err
 	}
-	req.Header.Set("CSRF-Token", client.Auth.Nonce)
+	req.Header.Set("CSRF-Token", nonce)
 	req.Header.Set("Content-Type", "application/json")
 
 	res, err := client.HttpClient.Do(req)
@@ -0,0 +1,87 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ChallengeTopic - association of a topic with a challenge
+type ChallengeTopic struct {
+	Id          uint   `json:"id"`
+	ChallengeId uint   `json:"challenge_id"`
+	TopicId     uint   `json:"topic_id"`
+	Value       string `json:"value"`
+}
+
+// GetChallengeTopics - Returns the topics of a challenge
+func (client *Client) GetChallengeTopics(ctx context.Context, challengeId uint) (*[]ChallengeTopic, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/challenges/%d/topics", client.HostUrl, challengeId), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	topics := new([]ChallengeTopic)
+	err = json.Unmarshal(*body, &topics)
+	if err != nil {
+		return nil, err
+	}
+
+	return topics, nil
+}
+
+// CreateChallengeTopic - add a topic to a challenge, creating the topic if required
+func (client *Client) CreateChallengeTopic(ctx context.Context, challengeId uint, value string) (*ChallengeTopic, error) {
+	topic := map[string]interface{}{
+		"challenge_id": challengeId,
+		"type":         "challenge",
+		"value":        value,
+	}
+	rb, err := json.Marshal(topic)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/topics", client.HostUrl), strings.NewReader(string(rb)))
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := client.DoApiRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	newTopic := new(ChallengeTopic)
+	err = json.Unmarshal(*body, &newTopic)
+	if err != nil {
+		return nil, err
+	}
+	newTopic.Value = value
+
+	return newTopic, nil
+}
+
+// DeleteChallengeTopic - remove a topic from a challenge
+func (client *Client) DeleteChallengeTopic(ctx context.Context, id uint) error {
+	emptyRequest := []byte("{}")
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/topics?type=challenge&target_id=%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	if err != nil {
+		return err
+	}
+
+	_, err = client.DoApiRequest(req)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// TransportConfig - TLS, proxy and timeout settings for requests to CTFd
+type TransportConfig struct {
+	CaCertPem          string
+	ClientCertPem      string
+	ClientKeyPem       string
+	InsecureSkipVerify bool
+	RequestTimeout     time.Duration
+	ProxyUrl           string
+}
+
+// newTransport - an `http.Transport` based on the default, altered per `config`
+func newTransport(config TransportConfig) (*http.Transport, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	}
+
+	if config.CaCertPem != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(config.CaCertPem)) {
+			return nil, errors.New("unable to parse CA certificate(s)")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if config.ClientCertPem != "" || config.ClientKeyPem != "" {
+		certificate, err := tls.X509KeyPair([]byte(config.ClientCertPem), []byte(config.ClientKeyPem))
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if config.ProxyUrl != "" {
+		proxyUrl, err := url.Parse(config.ProxyUrl)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyUrl)
+	}
+
+	return transport, nil
+}
+
+// ConfigureTransport - apply `config` to all subsequent requests
+func (client *Client) ConfigureTransport(config TransportConfig) error {
+	transport, err := newTransport(config)
+	if err != nil {
+		return err
+	}
+
+	client.HttpClient.Transport = newLoggingTransport(transport)
+	if config.RequestTimeout > 0 {
+		client.HttpClient.Timeout = config.RequestTimeout
+	}
+
+	return nil
+}
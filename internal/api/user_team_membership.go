@@ -1,6 +1,7 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -14,7 +15,7 @@ type UserTeamMembership struct {
 }
 
 // CreateUserTeamMembership - create a new userTeamMembership
-func (client *Client) CreateUserTeamMembership(teamId uint, userId uint) (*UserTeamMembership, error) {
+func (client *Client) CreateUserTeamMembership(ctx context.Context, teamId uint, userId uint) (*UserTeamMembership, error) {
 	userTeamMembership := map[string]interface{}{
 		"user_id": userId,
 	}
@@ -23,7 +24,7 @@ func (client *Client) CreateUserTeamMembership(teamId uint, userId uint) (*UserT
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/teams/%d/members", client.HostUrl, teamId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/teams/%d/members", client.HostUrl, teamId), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +43,7 @@ func (client *Client) CreateUserTeamMembership(teamId uint, userId uint) (*UserT
 }
 
 // DeleteUserTeamMembership - remove an existing userTeamMembership
-func (client *Client) DeleteUserTeamMembership(teamId uint, userId uint) error {
+func (client *Client) DeleteUserTeamMembership(ctx context.Context, teamId uint, userId uint) error {
 	userTeamMembership := map[string]interface{}{
 		"user_id": userId,
 	}
@@ -51,7 +52,7 @@ func (client *Client) DeleteUserTeamMembership(teamId uint, userId uint) error {
 		return err
 	}
 
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/teams/%d/members", client.HostUrl, teamId), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/teams/%d/members", client.HostUrl, teamId), strings.NewReader(string(rb)))
 	if err != nil {
 		return err
 	}
@@ -2,9 +2,11 @@ package api
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 )
 
@@ -24,38 +26,72 @@ type NewUser struct {
 
 // User - fields as returned from the CTFd API
 type User struct {
-	Id          uint     `json:"id"`
-	Name        string   `json:"name"`
-	Email       string   `json:"email"`
-	Password    string   `json:"password"`
-	Website     string   `json:"website"`
-	Affiliation string   `json:"affiliation"`
-	Country     string   `json:"country"`
-	Bracket     string   `json:"bracket"`
-	Secret      string   `json:"secret"`
-	OauthId     string   `json:"oauth_id"`
-	Fields      []string `json:"fields"`
-	Type        string   `json:"type"`
-	TeamId      uint     `json:"team_id"`
-	Verified    bool     `json:"verified"`
-	Hidden      bool     `json:"hidden"`
-	Banned      bool     `json:"banned"`
+	Id          uint         `json:"id"`
+	Name        string       `json:"name"`
+	Email       string       `json:"email"`
+	Password    string       `json:"password"`
+	Website     string       `json:"website"`
+	Affiliation string       `json:"affiliation"`
+	Country     string       `json:"country"`
+	Bracket     string       `json:"bracket"`
+	Secret      string       `json:"secret"`
+	OauthId     *uint        `json:"oauth_id"`
+	Fields      []FieldEntry `json:"fields"`
+	Type        string       `json:"type"`
+	TeamId      uint         `json:"team_id"`
+	Verified    bool         `json:"verified"`
+	Hidden      bool         `json:"hidden"`
+	Banned      bool         `json:"banned"`
+	Created     string       `json:"created"`
 }
 
-// GetUsers - Returns list of users
-func (client *Client) GetUsers() (interface{}, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/users", client.HostUrl), nil)
+// FieldValue - the value of a custom field; CTFd returns a string for `text`
+// fields and a boolean for `boolean` ones
+type FieldValue string
+
+func (value *FieldValue) UnmarshalJSON(data []byte) error {
+	var raw interface{}
+	err := json.Unmarshal(data, &raw)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	body, err := client.DoApiRequest(req)
-	if err != nil {
-		return nil, err
+	switch v := raw.(type) {
+	case nil:
+		*value = ""
+	case string:
+		*value = FieldValue(v)
+	case bool:
+		*value = FieldValue(strconv.FormatBool(v))
+	default:
+		*value = FieldValue(data)
 	}
 
-	users := make([]map[string]interface{}, 0)
-	err = json.Unmarshal(*body, &users)
+	return nil
+}
+
+// FieldEntry - a user's or team's entry for a custom field
+type FieldEntry struct {
+	FieldId     uint       `json:"field_id"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	Type        string     `json:"type"`
+	Value       FieldValue `json:"value"`
+}
+
+// GetUsers - Returns list of users, across all pages
+func (client *Client) GetUsers(ctx context.Context, options ListOptions) ([]User, error) {
+	users := make([]User, 0)
+	err := client.getAllPages(ctx, "/api/v1/users", options, func(body *json.RawMessage) error {
+		page := make([]User, 0)
+		err := json.Unmarshal(*body, &page)
+		if err != nil {
+			return err
+		}
+		users = append(users, page...)
+
+		return nil
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +100,8 @@ func (client *Client) GetUsers() (interface{}, error) {
 }
 
 // GetUser - Returns details of a user
-func (client *Client) GetUser(id uint) (*User, error) {
-	req, err := http.NewRequest("GET", fmt.Sprintf("%s/api/v1/users/%d", client.HostUrl, id), nil)
+func (client *Client) GetUser(ctx context.Context, id uint) (*User, error) {
+	req, err := http.NewRequestWithContext(ctx, "GET", fmt.Sprintf("%s/api/v1/users/%d", client.HostUrl, id), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -85,13 +121,13 @@ func (client *Client) GetUser(id uint) (*User, error) {
 }
 
 // CreateUser - create a new user
-func (client *Client) CreateUser(user NewUser) (*User, error) {
+func (client *Client) CreateUser(ctx context.Context, user NewUser) (*User, error) {
 	rb, err := json.Marshal(user)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", fmt.Sprintf("%s/api/v1/users", client.HostUrl), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "POST", fmt.Sprintf("%s/api/v1/users", client.HostUrl), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -111,13 +147,13 @@ func (client *Client) CreateUser(user NewUser) (*User, error) {
 }
 
 // UpdateUser - updated an existing user
-func (client *Client) UpdateUser(id uint, user NewUser) (*User, error) {
+func (client *Client) UpdateUser(ctx context.Context, id uint, user NewUser) (*User, error) {
 	rb, err := json.Marshal(user)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("PATCH", fmt.Sprintf("%s/api/v1/users/%d", client.HostUrl, id), strings.NewReader(string(rb)))
+	req, err := http.NewRequestWithContext(ctx, "PATCH", fmt.Sprintf("%s/api/v1/users/%d", client.HostUrl, id), strings.NewReader(string(rb)))
 	if err != nil {
 		return nil, err
 	}
@@ -137,9 +173,9 @@ func (client *Client) UpdateUser(id uint, user NewUser) (*User, error) {
 }
 
 // DeleteUser - remove an existing user
-func (client *Client) DeleteUser(id uint) error {
+func (client *Client) DeleteUser(ctx context.Context, id uint) error {
 	emptyRequest := []byte("{}")
-	req, err := http.NewRequest("DELETE", fmt.Sprintf("%s/api/v1/users/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
+	req, err := http.NewRequestWithContext(ctx, "DELETE", fmt.Sprintf("%s/api/v1/users/%d", client.HostUrl, id), bytes.NewBuffer(emptyRequest))
 	if err != nil {
 		return err
 	}
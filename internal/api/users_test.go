@@ -0,0 +1,113 @@
+package api
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+// a page of `GET /api/v1/users?view=admin` as returned by CTFd
+const usersPage = `[
+	{
+		"id": 7,
+		"oauth_id": 5678,
+		"name": "alice",
+		"email": "alice@example.com",
+		"type": "user",
+		"secret": null,
+		"website": null,
+		"affiliation": "University of Example",
+		"country": "GB",
+		"bracket": null,
+		"hidden": false,
+		"banned": false,
+		"verified": true,
+		"language": null,
+		"team_id": 3,
+		"created": "2024-01-01T00:00:00+00:00",
+		"fields": [
+			{"field_id": 3, "name": "Student", "description": "", "type": "boolean", "value": false}
+		]
+	},
+	{
+		"id": 8,
+		"oauth_id": null,
+		"name": "bob",
+		"email": "bob@example.com",
+		"type": "admin",
+		"secret": null,
+		"website": null,
+		"affiliation": null,
+		"country": null,
+		"bracket": null,
+		"hidden": true,
+		"banned": false,
+		"verified": false,
+		"language": null,
+		"team_id": null,
+		"created": "2024-01-02T00:00:00+00:00",
+		"fields": []
+	}
+]`
+
+func TestUserUnmarshalJSON(t *testing.T) {
+	oauthId := uint(5678)
+	want := []User{
+		{
+			Id:          7,
+			OauthId:     &oauthId,
+			Name:        "alice",
+			Email:       "alice@example.com",
+			Type:        "user",
+			Affiliation: "University of Example",
+			Country:     "GB",
+			Verified:    true,
+			TeamId:      3,
+			Created:     "2024-01-01T00:00:00+00:00",
+			Fields: []FieldEntry{
+				{FieldId: 3, Name: "Student", Type: "boolean", Value: "false"},
+			},
+		},
+		{
+			Id:      8,
+			Name:    "bob",
+			Email:   "bob@example.com",
+			Type:    "admin",
+			Hidden:  true,
+			Created: "2024-01-02T00:00:00+00:00",
+			Fields:  []FieldEntry{},
+		},
+	}
+
+	var users []User
+	if err := json.Unmarshal([]byte(usersPage), &users); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(users, want) {
+		t.Errorf("got %#v; want %#v", users, want)
+	}
+}
+
+func TestFieldValueUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		json string
+		want FieldValue
+	}{
+		{`"L"`, "L"},
+		{`true`, "true"},
+		{`false`, "false"},
+		{`null`, ""},
+		{`42`, "42"},
+	}
+
+	for _, c := range cases {
+		var value FieldValue
+		if err := json.Unmarshal([]byte(c.json), &value); err != nil {
+			t.Errorf("%s: %s", c.json, err)
+			continue
+		}
+		if value != c.want {
+			t.Errorf("%s: got %q; want %q", c.json, value, c.want)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"strconv"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// dataSourceId - a hash of the `arguments` of a data source and the
+// `contents` it read, so that the ID only changes when either does
+func dataSourceId(d *schema.ResourceData, arguments []string, contents interface{}) (string, error) {
+	values := map[string]interface{}{}
+	for _, argument := range arguments {
+		values[argument] = d.Get(argument)
+	}
+
+	// map keys are sorted when marshalled, so this is deterministic
+	encoded, err := json.Marshal([]interface{}{values, contents})
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// flattenOauthId - the MajorLeagueCyber ID of a user or team, or "" if it
+// isn't linked; kept a string, as it always has been in state
+func flattenOauthId(oauthId *uint) string {
+	if oauthId == nil {
+		return ""
+	}
+
+	return strconv.Itoa(int(*oauthId))
+}
+
+func flattenFieldEntries(fields []api.FieldEntry) []interface{} {
+	flattened := make([]interface{}, 0, len(fields))
+	for _, field := range fields {
+		flattened = append(flattened, map[string]interface{}{
+			"field_id": int(field.FieldId),
+			"name":     field.Name,
+			"value":    string(field.Value),
+		})
+	}
+
+	return flattened
+}
+
+// fieldEntriesSchema - entries for the custom fields of a user or team
+func fieldEntriesSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Computed:    true,
+		Description: "Entries for custom fields; boolean values are `true` or `false`.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"field_id": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"name": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+				"value": {
+					Type:     schema.TypeString,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
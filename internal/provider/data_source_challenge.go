@@ -0,0 +1,162 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// findChallenge - the single challenge named exactly `name`; the admin view is
+// needed to include hidden challenges
+func findChallenge(ctx context.Context, client *api.Client, name string) (*api.Challenge, error) {
+	options := api.ListOptions{
+		Filters: map[string]string{
+			"name": name,
+			"view": "admin",
+		},
+	}
+	challenges, err := client.GetChallenges(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]api.Challenge, 0, 1)
+	for _, challenge := range challenges {
+		if challenge.Name == name {
+			matches = append(matches, challenge)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no challenge with name %q", name)
+	case 1:
+		return client.GetChallenge(ctx, matches[0].Id)
+	default:
+		return nil, fmt.Errorf("%d challenges with name %q; look up by id instead", len(matches), name)
+	}
+}
+
+func dataSourceChallengeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	var challenge *api.Challenge
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		intId, err := strconv.Atoi(id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		challenge, err = client.GetChallenge(ctx, uint(intId))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		challenge, err = findChallenge(ctx, client, d.Get("name").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(strconv.Itoa(int(challenge.Id)))
+	if err := d.Set("name", challenge.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", challenge.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("category", challenge.Category); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("value", challenge.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", challenge.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("max_attempts", challenge.MaxAttempts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("connection_info", challenge.ConnectionInfo); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", challenge.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("solves", challenge.Solves); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("tags", []string(challenge.Tags)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceChallenge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get details of a single challenge, by ID or name.",
+		ReadContext: dataSourceChallengeRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "ID of the challenge.",
+				ExactlyOneOf: []string{"id", "name"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Exact name of the challenge; an error if more than one challenge has it.",
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"value": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "One of `visible` or `hidden`.",
+			},
+			"max_attempts": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Maximum number of attempts; `0` for unlimited.",
+			},
+			"connection_info": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"solves": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"tags": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
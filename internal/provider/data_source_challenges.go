@@ -2,28 +2,102 @@ package provider
 
 import (
 	"context"
+	"regexp"
 
 	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+func flattenChallenges(challenges []api.Challenge) []interface{} {
+	flattened := make([]interface{}, 0, len(challenges))
+	for _, challenge := range challenges {
+		tags := make([]interface{}, 0, len(challenge.Tags))
+		for _, tag := range challenge.Tags {
+			tags = append(tags, map[string]interface{}{
+				"value": tag,
+			})
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":           int(challenge.Id),
+			"type":         challenge.Type,
+			"name":         challenge.Name,
+			"value":        challenge.Value,
+			"solves":       challenge.Solves,
+			"solved_by_me": challenge.SolvedByMe,
+			"category":     challenge.Category,
+			"tags":         tags,
+			"template":     challenge.Template,
+			"script":       challenge.Script,
+		})
+	}
+
+	return flattened
+}
+
+// filterChallenges - challenges matching the filter arguments; the list doesn't
+// include the state of each challenge, so `visibility` is only applied by CTFd,
+// via the `state` query parameter
+func filterChallenges(challenges []api.Challenge, d *schema.ResourceData) ([]api.Challenge, error) {
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return nil, err
+	}
+	category := d.Get("category").(string)
+
+	filtered := make([]api.Challenge, 0, len(challenges))
+	for _, challenge := range challenges {
+		if !nameRegex.MatchString(challenge.Name) {
+			continue
+		}
+		if category != "" && challenge.Category != category {
+			continue
+		}
+		filtered = append(filtered, challenge)
+	}
+
+	return filtered, nil
+}
+
 func dataSourceChallengesRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
 	var diags diag.Diagnostics
 
-	challenges, err := client.GetChallenges()
+	// without the admin view CTFd omits hidden challenges, whatever `state`
+	options := api.ListOptions{
+		Filters: map[string]string{
+			"view": "admin",
+		},
+	}
+	if category := d.Get("category").(string); category != "" {
+		options.Filters["category"] = category
+	}
+	if visibility := d.Get("visibility").(string); visibility != "" {
+		options.Filters["state"] = visibility
+	}
+
+	challenges, err := client.GetChallenges(ctx, options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	challenges, err = filterChallenges(challenges, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("challenges", challenges); err != nil {
+	flattened := flattenChallenges(challenges)
+	if err := d.Set("challenges", flattened); err != nil {
 		return diag.FromErr(err)
 	}
 
-	// TODO: determine based on contents of `challenges`?
-	d.SetId("challenges")
+	id, err := dataSourceId(d, []string{"name_regex", "category", "visibility"}, flattened)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
 
 	return diags
 }
@@ -33,6 +107,23 @@ func dataSourceChallenges() *schema.Resource {
 		Description: "Get a list of the current challenges.",
 		ReadContext: dataSourceChallengesRead,
 		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only include challenges whose name matches this regular expression.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"category": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include challenges in this category.",
+			},
+			"visibility": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only include challenges in this state, either `visible` or `hidden`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"visible", "hidden"}, false)),
+			},
 			"challenges": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -0,0 +1,120 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenChallenges(t *testing.T) {
+	cases := []struct {
+		name       string
+		challenges []api.Challenge
+		want       []interface{}
+	}{
+		{
+			name:       "none",
+			challenges: []api.Challenge{},
+			want:       []interface{}{},
+		},
+		{
+			name: "with tags",
+			challenges: []api.Challenge{
+				{
+					Id:         1,
+					Type:       "standard",
+					Name:       "Warmup",
+					Value:      100,
+					Solves:     5,
+					SolvedByMe: true,
+					Category:   "Web",
+					Tags:       api.ChallengeTags{"web", "easy"},
+					Template:   "/plugins/challenges/assets/view.html",
+					Script:     "/plugins/challenges/assets/view.js",
+				},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"id":           1,
+					"type":         "standard",
+					"name":         "Warmup",
+					"value":        100,
+					"solves":       5,
+					"solved_by_me": true,
+					"category":     "Web",
+					"tags": []interface{}{
+						map[string]interface{}{"value": "web"},
+						map[string]interface{}{"value": "easy"},
+					},
+					"template": "/plugins/challenges/assets/view.html",
+					"script":   "/plugins/challenges/assets/view.js",
+				},
+			},
+		},
+		{
+			name: "without tags",
+			challenges: []api.Challenge{
+				{
+					Id:   2,
+					Type: "dynamic",
+					Name: "Crypto",
+				},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"id":           2,
+					"type":         "dynamic",
+					"name":         "Crypto",
+					"value":        0,
+					"solves":       0,
+					"solved_by_me": false,
+					"category":     "",
+					"tags":         []interface{}{},
+					"template":     "",
+					"script":       "",
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := flattenChallenges(c.challenges)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %#v; want %#v", got, c.want)
+			}
+
+			d := schema.TestResourceDataRaw(t, dataSourceChallenges().Schema, map[string]interface{}{})
+			if err := d.Set("challenges", got); err != nil {
+				t.Errorf("flattened challenges don't fit the schema: %s", err)
+			}
+		})
+	}
+}
+
+// TestFlattenChallengesTagForms - tags flatten the same whichever form CTFd
+// returned them in
+func TestFlattenChallengesTagForms(t *testing.T) {
+	want := []interface{}{
+		map[string]interface{}{"value": "web"},
+	}
+
+	for _, body := range []string{
+		`[{"id": 1, "tags": [{"value": "web"}]}]`,
+		`[{"id": 1, "tags": ["web"]}]`,
+	} {
+		var challenges []api.Challenge
+		if err := json.Unmarshal([]byte(body), &challenges); err != nil {
+			t.Fatal(err)
+		}
+
+		flattened := flattenChallenges(challenges)
+		got := flattened[0].(map[string]interface{})["tags"]
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("%s: got %#v; want %#v", body, got, want)
+		}
+	}
+}
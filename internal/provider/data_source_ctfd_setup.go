@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceCtfdSetupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	setup, err := client.GetCtfdSetup(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(setup.Name)
+	if err := d.Set("name", setup.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", setup.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_mode", setup.UserMode); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceCtfdSetup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the setup of the CTFd instance, e.g. whether it is in teams or users mode.",
+		ReadContext: dataSourceCtfdSetupRead,
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"user_mode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Either `teams` or `users`.",
+			},
+		},
+	}
+}
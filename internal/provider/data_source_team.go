@@ -0,0 +1,210 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// findTeam - the single team whose `field` is exactly `value`; CTFd's own
+// search is a substring match, so is only used to narrow the list, and the
+// admin view is needed to include hidden and banned teams
+func findTeam(ctx context.Context, client *api.Client, field string, value string) (*api.Team, error) {
+	options := api.ListOptions{
+		Filters: map[string]string{
+			"field": field,
+			"q":     value,
+			"view":  "admin",
+		},
+	}
+	teams, err := client.GetTeams(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]api.Team, 0, 1)
+	for _, team := range teams {
+		if (field == "name" && team.Name == value) || (field == "email" && team.Email == value) {
+			matches = append(matches, team)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no team with %s %q", field, value)
+	case 1:
+		return client.GetTeam(ctx, matches[0].Id)
+	default:
+		return nil, fmt.Errorf("%d teams with %s %q; look up by id instead", len(matches), field, value)
+	}
+}
+
+func dataSourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	if diags := requireTeamsMode(ctx, client, "ctfd_team"); diags.HasError() {
+		return diags
+	}
+
+	var diags diag.Diagnostics
+
+	var team *api.Team
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		intId, err := strconv.Atoi(id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		team, err = client.GetTeam(ctx, uint(intId))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if name, ok := d.GetOk("name"); ok {
+		team, err = findTeam(ctx, client, "name", name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		team, err = findTeam(ctx, client, "email", d.Get("email").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	captainId := 0
+	if team.CaptainId != nil {
+		captainId = int(*team.CaptainId)
+	}
+	members := make([]interface{}, 0, len(team.Members))
+	for _, member := range team.Members {
+		members = append(members, int(member))
+	}
+
+	d.SetId(strconv.Itoa(int(team.Id)))
+	if err := d.Set("name", team.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", team.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("website", team.Website); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("affiliation", team.Affiliation); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("country", team.Country); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("bracket", team.Bracket); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret", team.Secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("oauth_id", flattenOauthId(team.OauthId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fields", flattenFieldEntries(team.Fields)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("captain_id", captainId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("members", members); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hidden", team.Hidden); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("banned", team.Banned); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created", team.Created); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceTeam() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get details of a single team, by ID, name or email.",
+		ReadContext: dataSourceTeamRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "ID of the team.",
+				ExactlyOneOf: []string{"id", "name", "email"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Exact name of the team; an error if more than one team has it.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Exact email address of the team; an error if more than one team has it.",
+			},
+			"website": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"affiliation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bracket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"oauth_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fields": fieldEntriesSchema(),
+			"captain_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the team's captain; `0` if there isn't one.",
+			},
+			"members": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "IDs of the users in the team.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"hidden": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"banned": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
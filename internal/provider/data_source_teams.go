@@ -2,30 +2,122 @@ package provider
 
 import (
 	"context"
-	"strconv"
-	"time"
+	"regexp"
 
 	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
+func flattenTeams(teams []api.Team) []interface{} {
+	flattened := make([]interface{}, 0, len(teams))
+	for _, team := range teams {
+		captainId := 0
+		if team.CaptainId != nil {
+			captainId = int(*team.CaptainId)
+		}
+
+		members := make([]interface{}, 0, len(team.Members))
+		for _, member := range team.Members {
+			members = append(members, int(member))
+		}
+
+		flattened = append(flattened, map[string]interface{}{
+			"id":          int(team.Id),
+			"name":        team.Name,
+			"email":       team.Email,
+			"website":     team.Website,
+			"affiliation": team.Affiliation,
+			"country":     team.Country,
+			"hidden":      team.Hidden,
+			"banned":      team.Banned,
+			"captain_id":  captainId,
+			"bracket":     team.Bracket,
+			"secret":      team.Secret,
+			"oauth_id":    flattenOauthId(team.OauthId),
+			"members":     members,
+			"created":     team.Created,
+			"fields":      flattenFieldEntries(team.Fields),
+		})
+	}
+
+	return flattened
+}
+
+// filterTeams - teams matching the filter arguments; CTFd already filters on
+// those it supports, but not all versions support all of them
+func filterTeams(teams []api.Team, d *schema.ResourceData) ([]api.Team, error) {
+	nameRegex, err := regexp.Compile(d.Get("name_regex").(string))
+	if err != nil {
+		return nil, err
+	}
+	affiliation := d.Get("affiliation").(string)
+	country := d.Get("country").(string)
+	bracket := d.Get("bracket").(string)
+
+	filtered := make([]api.Team, 0, len(teams))
+	for _, team := range teams {
+		if !nameRegex.MatchString(team.Name) {
+			continue
+		}
+		if affiliation != "" && team.Affiliation != affiliation {
+			continue
+		}
+		if country != "" && team.Country != country {
+			continue
+		}
+		if bracket != "" && team.Bracket != bracket {
+			continue
+		}
+		filtered = append(filtered, team)
+	}
+
+	return filtered, nil
+}
+
 func dataSourceTeamsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
+	if diags := requireTeamsMode(ctx, client, "ctfd_teams"); diags.HasError() {
+		return diags
+	}
+
 	var diags diag.Diagnostics
 
-	teams, err := client.GetTeams()
+	options := api.ListOptions{
+		PerPage: uint(d.Get("per_page").(int)),
+		// without the admin view CTFd omits hidden and banned teams
+		Filters: map[string]string{
+			"view": "admin",
+		},
+	}
+	for _, key := range []string{"affiliation", "country"} {
+		if value := d.Get(key).(string); value != "" {
+			options.Filters[key] = value
+		}
+	}
+
+	teams, err := client.GetTeams(ctx, options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	teams, err = filterTeams(teams, d)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	if err := d.Set("teams", teams); err != nil {
+	flattened := flattenTeams(teams)
+	if err := d.Set("teams", flattened); err != nil {
 		return diag.FromErr(err)
 	}
 
-	d.SetId(strconv.FormatInt(time.Now().Unix(), 10))
+	id, err := dataSourceId(d, []string{"name_regex", "affiliation", "country", "bracket"}, flattened)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
 
 	return diags
 }
@@ -35,13 +127,40 @@ func dataSourceTeams() *schema.Resource {
 		Description: "Get a list of the registered teams.",
 		ReadContext: dataSourceTeamsRead,
 		Schema: map[string]*schema.Schema{
+			"name_regex": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only include teams whose name matches this regular expression.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringIsValidRegExp),
+			},
+			"affiliation": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include teams with this affiliation.",
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include teams from this country.",
+			},
+			"bracket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only include teams in this bracket.",
+			},
+			"per_page": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "Teams requested per page; all pages are read regardless. Defaults to CTFd's own page size.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
 			"teams": {
 				Type:     schema.TypeList,
 				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"id": {
-							Type:     schema.TypeString,
+							Type:     schema.TypeInt,
 							Computed: true,
 						},
 						"name": {
@@ -104,14 +223,7 @@ func dataSourceTeams() *schema.Resource {
 							Type:     schema.TypeString,
 							Computed: true,
 						},
-						"fields": {
-							Type:     schema.TypeList,
-							Computed: true,
-							Elem: &schema.Schema{
-								Type: schema.TypeString,
-							},
-							MinItems: 0,
-						},
+						"fields": fieldEntriesSchema(),
 					},
 				},
 			},
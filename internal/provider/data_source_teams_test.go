@@ -0,0 +1,146 @@
+package provider
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestFlattenTeams(t *testing.T) {
+	captainId, oauthId := uint(7), uint(1234)
+
+	cases := []struct {
+		name  string
+		teams []api.Team
+		want  []interface{}
+	}{
+		{
+			name:  "none",
+			teams: []api.Team{},
+			want:  []interface{}{},
+		},
+		{
+			name: "with captain and members",
+			teams: []api.Team{
+				{
+					Id:          3,
+					Name:        "Red Team",
+					Email:       "red@example.com",
+					Website:     "https://red.example.com",
+					Affiliation: "University of Example",
+					Country:     "GB",
+					Hidden:      true,
+					Banned:      false,
+					CaptainId:   &captainId,
+					Bracket:     "students",
+					Secret:      "secret",
+					OauthId:     &oauthId,
+					Members:     []uint{7, 8},
+					Created:     "2024-01-01T00:00:00+00:00",
+					Fields: []api.FieldEntry{
+						{FieldId: 1, Name: "Shirt size", Type: "text", Value: "L"},
+						{FieldId: 2, Name: "Students", Type: "boolean", Value: "true"},
+					},
+				},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"id":          3,
+					"name":        "Red Team",
+					"email":       "red@example.com",
+					"website":     "https://red.example.com",
+					"affiliation": "University of Example",
+					"country":     "GB",
+					"hidden":      true,
+					"banned":      false,
+					"captain_id":  7,
+					"bracket":     "students",
+					"secret":      "secret",
+					"oauth_id":    "1234",
+					"members":     []interface{}{7, 8},
+					"created":     "2024-01-01T00:00:00+00:00",
+					"fields": []interface{}{
+						map[string]interface{}{"field_id": 1, "name": "Shirt size", "value": "L"},
+						map[string]interface{}{"field_id": 2, "name": "Students", "value": "true"},
+					},
+				},
+			},
+		},
+		{
+			name: "without captain or members",
+			teams: []api.Team{
+				{
+					Id:   4,
+					Name: "Blue Team",
+				},
+			},
+			want: []interface{}{
+				map[string]interface{}{
+					"id":          4,
+					"name":        "Blue Team",
+					"email":       "",
+					"website":     "",
+					"affiliation": "",
+					"country":     "",
+					"hidden":      false,
+					"banned":      false,
+					"captain_id":  0,
+					"bracket":     "",
+					"secret":      "",
+					"oauth_id":    "",
+					"members":     []interface{}{},
+					"created":     "",
+					"fields":      []interface{}{},
+				},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := flattenTeams(c.teams)
+			if !reflect.DeepEqual(got, c.want) {
+				t.Errorf("got %#v; want %#v", got, c.want)
+			}
+
+			d := schema.TestResourceDataRaw(t, dataSourceTeams().Schema, map[string]interface{}{})
+			if err := d.Set("teams", got); err != nil {
+				t.Errorf("flattened teams don't fit the schema: %s", err)
+			}
+		})
+	}
+}
+
+// TestFlattenTeamsFromApi - teams as CTFd returns them, with an `oauth_id`
+// and custom field entries, flatten to fit the schema
+func TestFlattenTeamsFromApi(t *testing.T) {
+	body := `[{"id": 3, "oauth_id": 1234, "name": "Red Team", "captain_id": null, "members": [], "fields": [{"field_id": 2, "name": "Students", "description": "", "type": "boolean", "value": true}]}]`
+
+	var teams []api.Team
+	if err := json.Unmarshal([]byte(body), &teams); err != nil {
+		t.Fatal(err)
+	}
+
+	flattened := flattenTeams(teams)
+	team := flattened[0].(map[string]interface{})
+	if team["oauth_id"] != "1234" {
+		t.Errorf("got oauth_id %#v; want \"1234\"", team["oauth_id"])
+	}
+	if team["captain_id"] != 0 {
+		t.Errorf("got captain_id %#v; want 0", team["captain_id"])
+	}
+	wantFields := []interface{}{
+		map[string]interface{}{"field_id": 2, "name": "Students", "value": "true"},
+	}
+	if !reflect.DeepEqual(team["fields"], wantFields) {
+		t.Errorf("got fields %#v; want %#v", team["fields"], wantFields)
+	}
+
+	d := schema.TestResourceDataRaw(t, dataSourceTeams().Schema, map[string]interface{}{})
+	if err := d.Set("teams", flattened); err != nil {
+		t.Errorf("flattened teams don't fit the schema: %s", err)
+	}
+}
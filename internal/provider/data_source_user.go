@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// findUser - the single user whose `field` is exactly `value`; CTFd's own
+// search is a substring match, so is only used to narrow the list, and the
+// admin view is needed to include hidden and banned users
+func findUser(ctx context.Context, client *api.Client, field string, value string) (*api.User, error) {
+	options := api.ListOptions{
+		Filters: map[string]string{
+			"field": field,
+			"q":     value,
+			"view":  "admin",
+		},
+	}
+	users, err := client.GetUsers(ctx, options)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := make([]api.User, 0, 1)
+	for _, user := range users {
+		if (field == "name" && user.Name == value) || (field == "email" && user.Email == value) {
+			matches = append(matches, user)
+		}
+	}
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("no user with %s %q", field, value)
+	case 1:
+		return client.GetUser(ctx, matches[0].Id)
+	default:
+		return nil, fmt.Errorf("%d users with %s %q; look up by id instead", len(matches), field, value)
+	}
+}
+
+func dataSourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	var user *api.User
+	var err error
+	if id, ok := d.GetOk("id"); ok {
+		intId, err := strconv.Atoi(id.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		user, err = client.GetUser(ctx, uint(intId))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else if name, ok := d.GetOk("name"); ok {
+		user, err = findUser(ctx, client, "name", name.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		user, err = findUser(ctx, client, "email", d.Get("email").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId(strconv.Itoa(int(user.Id)))
+	if err := d.Set("name", user.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", user.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("website", user.Website); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("affiliation", user.Affiliation); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("country", user.Country); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("bracket", user.Bracket); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret", user.Secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("oauth_id", flattenOauthId(user.OauthId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fields", flattenFieldEntries(user.Fields)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", user.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("team_id", int(user.TeamId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verified", user.Verified); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hidden", user.Hidden); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("banned", user.Banned); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created", user.Created); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func dataSourceUser() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get details of a single user, by ID, name or email.",
+		ReadContext: dataSourceUserRead,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "ID of the user.",
+				ExactlyOneOf: []string{"id", "name", "email"},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Exact name of the user; an error if more than one user has it.",
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Exact email address of the user; an error if more than one user has it.",
+			},
+			"website": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"affiliation": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"country": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"bracket": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"secret": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+			"oauth_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"fields": fieldEntriesSchema(),
+			"type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"team_id": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "ID of the user's team; `0` if they aren't in one.",
+			},
+			"verified": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"hidden": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"banned": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
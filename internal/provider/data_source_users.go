@@ -0,0 +1,222 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func flattenUsers(users []api.User) []interface{} {
+	flattened := make([]interface{}, 0, len(users))
+	for _, user := range users {
+		flattened = append(flattened, map[string]interface{}{
+			"id":          int(user.Id),
+			"name":        user.Name,
+			"email":       user.Email,
+			"website":     user.Website,
+			"affiliation": user.Affiliation,
+			"country":     user.Country,
+			"bracket":     user.Bracket,
+			"oauth_id":    flattenOauthId(user.OauthId),
+			"fields":      flattenFieldEntries(user.Fields),
+			"type":        user.Type,
+			"team_id":     int(user.TeamId),
+			"verified":    user.Verified,
+			"hidden":      user.Hidden,
+			"banned":      user.Banned,
+			"created":     user.Created,
+		})
+	}
+
+	return flattened
+}
+
+// optionalBool - the configured value of a boolean argument, or `nil` if it
+// was left unset; `d.GetOk` can't tell `false` apart from unset
+func optionalBool(d *schema.ResourceData, key string) *bool {
+	config := d.GetRawConfig()
+	if config.IsNull() || !config.IsKnown() {
+		return nil
+	}
+	value := config.GetAttr(key)
+	if value.IsNull() || !value.IsKnown() {
+		return nil
+	}
+	b := value.True()
+
+	return &b
+}
+
+// filterUsers - users matching the filter arguments
+func filterUsers(users []api.User, d *schema.ResourceData) []api.User {
+	userType := d.Get("type").(string)
+	teamId := uint(d.Get("team_id").(int))
+	verified := optionalBool(d, "verified")
+	banned := optionalBool(d, "banned")
+	hidden := optionalBool(d, "hidden")
+
+	filtered := make([]api.User, 0, len(users))
+	for _, user := range users {
+		if userType != "" && user.Type != userType {
+			continue
+		}
+		if teamId != 0 && user.TeamId != teamId {
+			continue
+		}
+		if verified != nil && user.Verified != *verified {
+			continue
+		}
+		if banned != nil && user.Banned != *banned {
+			continue
+		}
+		if hidden != nil && user.Hidden != *hidden {
+			continue
+		}
+		filtered = append(filtered, user)
+	}
+
+	return filtered
+}
+
+func dataSourceUsersRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	// without the admin view CTFd omits hidden and banned users, along with
+	// the `type`, `verified`, `banned` and `hidden` of the others
+	options := api.ListOptions{
+		PerPage: uint(d.Get("per_page").(int)),
+		Filters: map[string]string{
+			"view": "admin",
+		},
+	}
+
+	users, err := client.GetUsers(ctx, options)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flattened := flattenUsers(filterUsers(users, d))
+	if err := d.Set("users", flattened); err != nil {
+		return diag.FromErr(err)
+	}
+
+	id, err := dataSourceId(d, []string{"type", "team_id", "verified", "banned", "hidden"}, flattened)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	d.SetId(id)
+
+	return diags
+}
+
+func dataSourceUsers() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get a list of the registered users.",
+		ReadContext: dataSourceUsersRead,
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Only include users of this type, either `admin` or `user`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"admin", "user"}, false)),
+			},
+			"team_id": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "Only include members of this team.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"verified": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only include users whose email address is, or isn't, verified.",
+			},
+			"banned": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only include users who are, or aren't, banned.",
+			},
+			"hidden": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only include users who are, or aren't, hidden.",
+			},
+			"per_page": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Description:      "Users requested per page; all pages are read regardless. Defaults to CTFd's own page size.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"users": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"email": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"website": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"affiliation": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"bracket": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"oauth_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"fields": fieldEntriesSchema(),
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"team_id": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"verified": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"hidden": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"banned": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
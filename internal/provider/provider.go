@@ -2,8 +2,12 @@ package provider
 
 import (
 	"context"
+	"os"
+	"time"
+
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 
 	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
 )
@@ -30,29 +34,131 @@ func New(version string) func() *schema.Provider {
 			Schema: map[string]*schema.Schema{
 				"username": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Admin. username",
+					Optional:    true,
+					DefaultFunc: schema.EnvDefaultFunc("CTFD_USERNAME", nil),
+					Description: "Admin. username. May also be set via `CTFD_USERNAME`.",
 				},
 				"password": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Admin. password",
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("CTFD_PASSWORD", nil),
+					Description: "Admin. password. May also be set via `CTFD_PASSWORD`.",
 				},
-				"url": {
+				"access_token": {
 					Type:        schema.TypeString,
-					Required:    true,
-					Description: "Base URL of CTFd instance",
+					Optional:    true,
+					Sensitive:   true,
+					DefaultFunc: schema.EnvDefaultFunc("CTFD_ACCESS_TOKEN", nil),
+					Description: "Admin. access token; used instead of `username` and `password`. May also be set via `CTFD_ACCESS_TOKEN`.",
+				},
+				"url": {
+					Type:             schema.TypeString,
+					Required:         true,
+					DefaultFunc:      schema.EnvDefaultFunc("CTFD_URL", nil),
+					Description:      "Base URL of CTFd instance. May also be set via `CTFD_URL`.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithHTTPorHTTPS),
+				},
+				"max_retries": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          api.DefaultRetryPolicy.MaxRetries,
+					Description:      "Maximum number of retries of requests which fail transiently, e.g. with `429`, `502` or `503`.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				},
+				"min_retry_backoff": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          int(api.DefaultRetryPolicy.MinBackoff.Seconds()),
+					Description:      "Minimum time, in seconds, to wait before retrying a request.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				},
+				"max_retry_backoff": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          int(api.DefaultRetryPolicy.MaxBackoff.Seconds()),
+					Description:      "Maximum time, in seconds, to wait before retrying a request; a `Retry-After` from CTFd is always waited in full.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				},
+				"requests_per_second": {
+					Type:             schema.TypeFloat,
+					Optional:         true,
+					Default:          0,
+					Description:      "Maximum rate of requests to CTFd, shared by all resources; `0` for unlimited.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.FloatAtLeast(0)),
+				},
+				"max_concurrent_requests": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          0,
+					Description:      "Maximum number of requests to CTFd in flight at once; `0` for unlimited.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+				},
+				"ca_cert_pem": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "PEM-encoded CA certificate(s) to trust in addition to the system pool.",
+					ConflictsWith: []string{"ca_cert_file"},
+				},
+				"ca_cert_file": {
+					Type:          schema.TypeString,
+					Optional:      true,
+					Description:   "Path to a file of PEM-encoded CA certificate(s) to trust in addition to the system pool.",
+					ConflictsWith: []string{"ca_cert_pem"},
+				},
+				"client_cert": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Description:  "PEM-encoded client certificate for mutual TLS.",
+					RequiredWith: []string{"client_key"},
+				},
+				"client_key": {
+					Type:         schema.TypeString,
+					Optional:     true,
+					Sensitive:    true,
+					Description:  "PEM-encoded private key of `client_cert`.",
+					RequiredWith: []string{"client_cert"},
+				},
+				"insecure_skip_verify": {
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Skip verification of CTFd's TLS certificate.",
+				},
+				"request_timeout": {
+					Type:             schema.TypeInt,
+					Optional:         true,
+					Default:          30,
+					Description:      "Timeout, in seconds, of each request to CTFd.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+				},
+				"proxy_url": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Description:      "Proxy through which to reach CTFd; otherwise `HTTPS_PROXY`/`HTTP_PROXY` are honoured.",
+					ValidateDiagFunc: validation.ToDiagFunc(validation.IsURLWithScheme([]string{"http", "https", "socks5"})),
 				},
 			},
 			DataSourcesMap: map[string]*schema.Resource{
+				"ctfd_challenge":  dataSourceChallenge(),
 				"ctfd_challenges": dataSourceChallenges(),
+				"ctfd_setup":      dataSourceCtfdSetup(),
+				"ctfd_team":       dataSourceTeam(),
 				"ctfd_teams":      dataSourceTeams(),
+				"ctfd_user":       dataSourceUser(),
+				"ctfd_users":      dataSourceUsers(),
 			},
 			ResourcesMap: map[string]*schema.Resource{
-				"ctfd_setup":                resourceCtfdSetup(),
-				"ctfd_team":                 resourceTeam(),
-				"ctfd_user":                 resourceUser(),
-				"ctfd_user_team_membership": resourceUserTeamMembership(),
+				"ctfd_challenge":              resourceChallenge(),
+				"ctfd_challenge_file":         resourceChallengeFile(),
+				"ctfd_challenge_requirements": resourceChallengeRequirements(),
+				"ctfd_dynamic_challenge":      resourceDynamicChallenge(),
+				"ctfd_flag":                   resourceFlag(),
+				"ctfd_hint":                   resourceHint(),
+				"ctfd_setup":                  resourceCtfdSetup(),
+				"ctfd_team":                   resourceTeam(),
+				"ctfd_user":                   resourceUser(),
+				"ctfd_user_team_membership":   resourceUserTeamMembership(),
 			},
 		}
 
@@ -63,10 +169,11 @@ func New(version string) func() *schema.Provider {
 }
 
 func configure(version string, p *schema.Provider) func(context.Context, *schema.ResourceData) (interface{}, diag.Diagnostics) {
-	return func(c context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+	return func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 		ctfdUrl := d.Get("url").(string)
 		ctfdUsername := d.Get("username").(string)
 		ctfdPassword := d.Get("password").(string)
+		ctfdAccessToken := d.Get("access_token").(string)
 		userAgent := p.UserAgent("terraform-provider-ctfd", version)
 
 		client, err := api.NewClient(&ctfdUrl, &ctfdUsername, &ctfdPassword, &userAgent)
@@ -74,18 +181,56 @@ func configure(version string, p *schema.Provider) func(context.Context, *schema
 			return nil, diag.FromErr(err)
 		}
 
-		err = client.CheckSetup()
+		client.RetryPolicy = api.RetryPolicy{
+			MaxRetries: d.Get("max_retries").(int),
+			MinBackoff: time.Duration(d.Get("min_retry_backoff").(int)) * time.Second,
+			MaxBackoff: time.Duration(d.Get("max_retry_backoff").(int)) * time.Second,
+		}
+		if client.RetryPolicy.MinBackoff > client.RetryPolicy.MaxBackoff {
+			return nil, diag.Errorf("min_retry_backoff must not be greater than max_retry_backoff")
+		}
+		client.RateLimiter = api.NewRateLimiter(d.Get("requests_per_second").(float64), d.Get("max_concurrent_requests").(int))
+
+		caCertPem := d.Get("ca_cert_pem").(string)
+		if caCertFile := d.Get("ca_cert_file").(string); caCertFile != "" {
+			contents, err := os.ReadFile(caCertFile)
+			if err != nil {
+				return nil, diag.FromErr(err)
+			}
+			caCertPem = string(contents)
+		}
+		err = client.ConfigureTransport(api.TransportConfig{
+			CaCertPem:          caCertPem,
+			ClientCertPem:      d.Get("client_cert").(string),
+			ClientKeyPem:       d.Get("client_key").(string),
+			InsecureSkipVerify: d.Get("insecure_skip_verify").(bool),
+			RequestTimeout:     time.Duration(d.Get("request_timeout").(int)) * time.Second,
+			ProxyUrl:           d.Get("proxy_url").(string),
+		})
+		if err != nil {
+			return nil, diag.FromErr(err)
+		}
+
+		if ctfdAccessToken != "" {
+			client.SetToken(ctfdAccessToken)
+			return client, nil
+		}
+		if ctfdUsername == "" || ctfdPassword == "" {
+			return nil, diag.Errorf("either access_token or both username and password must be set")
+		}
+
+		err = client.CheckSetup(ctx)
 		if err == nil {
-			err = client.SignIn()
+			err = client.SignIn(ctx)
 			if err != nil {
 				return nil, diag.FromErr(err)
 			}
 
-			token, err := client.GetOrCreateToken()
+			token, err := client.GetOrCreateToken(ctx)
 			if err != nil {
 				return nil, diag.FromErr(err)
 			}
-			client.Auth.Token = token.Value
+			client.SetToken(token.Value)
 		}
 
 		return client, nil
@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+// reconcileChallengeTags - add and remove tags so that they match `values`
+func reconcileChallengeTags(ctx context.Context, client *api.Client, challengeId uint, values *schema.Set) error {
+	tags, err := client.GetChallengeTags(ctx, challengeId)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, tag := range *tags {
+		if !values.Contains(tag.Value) || existing[tag.Value] {
+			err := client.DeleteTag(ctx, tag.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		existing[tag.Value] = true
+	}
+
+	for _, v := range values.List() {
+		if existing[v.(string)] {
+			continue
+		}
+		_, err := client.CreateTag(ctx, challengeId, v.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reconcileChallengeTopics - add and remove topics so that they match `values`
+func reconcileChallengeTopics(ctx context.Context, client *api.Client, challengeId uint, values *schema.Set) error {
+	topics, err := client.GetChallengeTopics(ctx, challengeId)
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]bool{}
+	for _, topic := range *topics {
+		if !values.Contains(topic.Value) || existing[topic.Value] {
+			err := client.DeleteChallengeTopic(ctx, topic.Id)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		existing[topic.Value] = true
+	}
+
+	for _, v := range values.List() {
+		if existing[v.(string)] {
+			continue
+		}
+		_, err := client.CreateChallengeTopic(ctx, challengeId, v.(string))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateChallengeTagsAndTopics - reconcile `tags` and `topics` where changed
+func updateChallengeTagsAndTopics(ctx context.Context, client *api.Client, challengeId uint, d *schema.ResourceData) error {
+	if d.HasChange("tags") {
+		err := reconcileChallengeTags(ctx, client, challengeId, d.Get("tags").(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+	if d.HasChange("topics") {
+		err := reconcileChallengeTopics(ctx, client, challengeId, d.Get("topics").(*schema.Set))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readChallengeTagsAndTopics - set `tags` and `topics` from the CTFd API
+func readChallengeTagsAndTopics(ctx context.Context, client *api.Client, challengeId uint, d *schema.ResourceData) error {
+	tags, err := client.GetChallengeTags(ctx, challengeId)
+	if err != nil {
+		return err
+	}
+	tagValues := make([]interface{}, 0, len(*tags))
+	for _, tag := range *tags {
+		tagValues = append(tagValues, tag.Value)
+	}
+	if err := d.Set("tags", tagValues); err != nil {
+		return err
+	}
+
+	topics, err := client.GetChallengeTopics(ctx, challengeId)
+	if err != nil {
+		return err
+	}
+	topicValues := make([]interface{}, 0, len(*topics))
+	for _, topic := range *topics {
+		topicValues = append(topicValues, topic.Value)
+	}
+	if err := d.Set("topics", topicValues); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func resourceChallengeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	challenge := api.NewChallenge{
+		Name:           d.Get("name").(string),
+		Description:    d.Get("description").(string),
+		Category:       d.Get("category").(string),
+		Value:          d.Get("value").(int),
+		State:          d.Get("state").(string),
+		MaxAttempts:    d.Get("max_attempts").(int),
+		ConnectionInfo: d.Get("connection_info").(string),
+		Type:           d.Get("type").(string),
+	}
+
+	newChallenge, err := client.CreateChallenge(ctx, challenge)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(newChallenge.Id)))
+
+	err = updateChallengeTagsAndTopics(ctx, client, newChallenge.Id, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	challenge, err := client.GetChallenge(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(challenge.Id)))
+	if err := d.Set("name", challenge.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", challenge.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("category", challenge.Category); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("value", challenge.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", challenge.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("max_attempts", challenge.MaxAttempts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("connection_info", challenge.ConnectionInfo); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", challenge.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := readChallengeTagsAndTopics(ctx, client, challenge.Id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	challenge := new(api.NewChallenge)
+	challenge.Name = d.Get("name").(string)
+	challenge.Description = d.Get("description").(string)
+	challenge.Category = d.Get("category").(string)
+	challenge.Value = d.Get("value").(int)
+	challenge.State = d.Get("state").(string)
+	challenge.MaxAttempts = d.Get("max_attempts").(int)
+	challenge.ConnectionInfo = d.Get("connection_info").(string)
+	challenge.Type = d.Get("type").(string)
+
+	updatedChallenge, err := client.UpdateChallenge(ctx, uint(intId), *challenge)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(updatedChallenge.Id)))
+
+	err = updateChallengeTagsAndTopics(ctx, client, updatedChallenge.Id, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.DeleteChallenge(ctx, uint(intId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallenge() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a standard challenge.",
+		CreateContext: resourceChallengeCreate,
+		ReadContext:   resourceChallengeRead,
+		UpdateContext: resourceChallengeUpdate,
+		DeleteContext: resourceChallengeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"value": {
+				Type:     schema.TypeInt,
+				Required: true,
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "visible",
+				Description:      "One of `visible` or `hidden`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"visible", "hidden"}, false)),
+			},
+			"max_attempts": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				Description:      "Maximum number of attempts; `0` for unlimited.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"connection_info": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "standard",
+				ForceNew:    true,
+				Description: "Challenge type, as registered with CTFd.",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"topics": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
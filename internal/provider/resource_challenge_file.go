@@ -0,0 +1,221 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// fileDigest - hex-encoded digest of a local file
+func fileDigest(path string, hash hash.Hash) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// fileSha256 - hex-encoded SHA-256 digest of a local file
+func fileSha256(path string) (string, error) {
+	return fileDigest(path, sha256.New())
+}
+
+// parseChallengeFileId - split a `challenge_id.file_id` import ID into its parts
+func parseChallengeFileId(id string) (int, int, error) {
+	ids := strings.SplitN(id, ".", 2)
+	if len(ids) != 2 {
+		return 0, 0, fmt.Errorf("invalid ID %q; expected challenge_id.file_id", id)
+	}
+
+	challengeIntId, err := strconv.Atoi(ids[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	fileIntId, err := strconv.Atoi(ids[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return challengeIntId, fileIntId, nil
+}
+
+// suppressImportedChallengeFileDiff - `source` and `content_sha256` can't be
+// read back, so are empty after import; rather than upload the file again,
+// accept them while `source` matches the `sha1sum` of the file in CTFd
+func suppressImportedChallengeFileDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old != "" || d.Id() == "" {
+		return false
+	}
+
+	digest, err := fileDigest(d.Get("source").(string), sha1.New())
+	if err != nil {
+		return false
+	}
+
+	return digest == d.Get("sha1sum").(string)
+}
+
+func resourceChallengeFileCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	source := d.Get("source").(string)
+	contentSha256 := d.Get("content_sha256").(string)
+
+	digest, err := fileSha256(source)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if digest != contentSha256 {
+		return diag.FromErr(fmt.Errorf("content_sha256 %s does not match %s (%s)", contentSha256, source, digest))
+	}
+
+	newFile, err := client.CreateChallengeFile(ctx, uint(d.Get("challenge_id").(int)), source)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(newFile.Id)))
+	if err := d.Set("location", newFile.Location); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sha1sum", newFile.Sha1sum); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeFileRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	file, err := client.GetFile(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(file.Id)))
+	if err := d.Set("location", file.Location); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("sha1sum", file.Sha1sum); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeFileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.DeleteFile(ctx, uint(intId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+// resourceChallengeFileImport - import using `challenge_id.file_id`, as CTFd
+// doesn't report the challenge a file is attached to
+func resourceChallengeFileImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	challengeId, fileId, err := parseChallengeFileId(d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(strconv.Itoa(fileId))
+	if err := d.Set("challenge_id", challengeId); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceChallengeFile() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Upload a file and attach it to a challenge.",
+		CreateContext: resourceChallengeFileCreate,
+		ReadContext:   resourceChallengeFileRead,
+		DeleteContext: resourceChallengeFileDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceChallengeFileImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"challenge_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"source": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Path to the local file to upload.",
+				DiffSuppressFunc: suppressImportedChallengeFileDiff,
+			},
+			"content_sha256": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "SHA-256 of `source`, e.g. `filesha256(\"...\")`; a change causes the file to be re-uploaded.",
+				DiffSuppressFunc: suppressImportedChallengeFileDiff,
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Location of the file within CTFd's upload folder.",
+			},
+			"sha1sum": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SHA-1 of the file, as reported by CTFd.",
+			},
+		},
+	}
+}
@@ -0,0 +1,224 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func expandChallengePrerequisites(s *schema.Set) []uint {
+	prerequisites := make([]uint, 0, s.Len())
+	for _, v := range s.List() {
+		prerequisites = append(prerequisites, uint(v.(int)))
+	}
+
+	return prerequisites
+}
+
+// findChallengeRequirementsCycle - walk the prerequisites of each challenge
+// in `prerequisites`, returning the path back to `challengeId` if one exists
+func findChallengeRequirementsCycle(ctx context.Context, client *api.Client, challengeId uint, prerequisites []uint) ([]uint, error) {
+	visited := map[uint]bool{}
+	var visit func(id uint, path []uint) ([]uint, error)
+	visit = func(id uint, path []uint) ([]uint, error) {
+		path = append(path, id)
+		if id == challengeId {
+			return path, nil
+		}
+		if visited[id] {
+			return nil, nil
+		}
+		visited[id] = true
+
+		requirements, err := client.GetChallengeRequirements(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		for _, next := range requirements.Prerequisites {
+			cycle, err := visit(next, path)
+			if err != nil || cycle != nil {
+				return cycle, err
+			}
+		}
+
+		return nil, nil
+	}
+
+	for _, id := range prerequisites {
+		cycle, err := visit(id, []uint{challengeId})
+		if err != nil || cycle != nil {
+			return cycle, err
+		}
+	}
+
+	return nil, nil
+}
+
+func resourceChallengeRequirementsCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("challenge_id") || !d.NewValueKnown("prerequisites") {
+		return nil
+	}
+
+	client := meta.(*api.Client)
+
+	challengeId := uint(d.Get("challenge_id").(int))
+	prerequisites := expandChallengePrerequisites(d.Get("prerequisites").(*schema.Set))
+
+	cycle, err := findChallengeRequirementsCycle(ctx, client, challengeId, prerequisites)
+	if err != nil {
+		return err
+	}
+	if cycle != nil {
+		return fmt.Errorf("prerequisites of challenge %d form a cycle: %v", challengeId, cycle)
+	}
+
+	return nil
+}
+
+func resourceChallengeRequirementsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	challengeId := uint(d.Get("challenge_id").(int))
+	requirements := api.ChallengeRequirements{
+		Prerequisites: expandChallengePrerequisites(d.Get("prerequisites").(*schema.Set)),
+		Anonymize:     d.Get("anonymize").(bool),
+	}
+
+	err := client.UpdateChallengeRequirements(ctx, challengeId, requirements)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(challengeId)))
+
+	return diags
+}
+
+func resourceChallengeRequirementsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	requirements, err := client.GetChallengeRequirements(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prerequisites := make([]interface{}, 0, len(requirements.Prerequisites))
+	for _, v := range requirements.Prerequisites {
+		prerequisites = append(prerequisites, int(v))
+	}
+
+	if err := d.Set("challenge_id", intId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("prerequisites", prerequisites); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("anonymize", requirements.Anonymize); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeRequirementsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	requirements := api.ChallengeRequirements{
+		Prerequisites: expandChallengePrerequisites(d.Get("prerequisites").(*schema.Set)),
+		Anonymize:     d.Get("anonymize").(bool),
+	}
+
+	err = client.UpdateChallengeRequirements(ctx, uint(intId), requirements)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeRequirementsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = client.UpdateChallengeRequirements(ctx, uint(intId), api.ChallengeRequirements{})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceChallengeRequirements() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage the challenges which must be solved before a challenge is unlocked.",
+		CreateContext: resourceChallengeRequirementsCreate,
+		ReadContext:   resourceChallengeRequirementsRead,
+		UpdateContext: resourceChallengeRequirementsUpdate,
+		DeleteContext: resourceChallengeRequirementsDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: resourceChallengeRequirementsCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"challenge_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"prerequisites": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "IDs of challenges which must be solved first.",
+				Elem: &schema.Schema{
+					Type: schema.TypeInt,
+				},
+			},
+			"anonymize": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Show the locked challenge anonymously rather than hiding it.",
+			},
+		},
+	}
+}
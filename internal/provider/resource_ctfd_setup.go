@@ -2,10 +2,13 @@ package provider
 
 import (
 	"context"
+	"errors"
+	"time"
 
 	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func expandCtfdSetupEmailConfig(l []interface{}) *api.EmailConfig {
@@ -29,23 +32,49 @@ func expandCtfdSetupEmailConfig(l []interface{}) *api.EmailConfig {
 	return emailConfig
 }
 
-func resourceCtfdSetupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	client := meta.(*api.Client)
+func flattenCtfdSetupEmailConfig(emailConfig *api.EmailConfig) []interface{} {
+	if emailConfig == nil || emailConfig.Server == "" {
+		return []interface{}{}
+	}
 
-	var diags diag.Diagnostics
+	m := map[string]interface{}{
+		"username":     emailConfig.Username,
+		"password":     emailConfig.Password,
+		"from_address": emailConfig.FromAddress,
+		"server":       emailConfig.Server,
+		"port":         emailConfig.Port,
+		"use_auth":     emailConfig.UseAuth,
+		"use_tls":      emailConfig.UseTls,
+		"use_ssl":      emailConfig.UseSsl,
+	}
 
+	return []interface{}{m}
+}
+
+func expandCtfdSetup(d *schema.ResourceData) api.CtfdSetup {
 	setup := api.CtfdSetup{
 		Name:              d.Get("name").(string),
 		Description:       d.Get("description").(string),
 		AdminEmail:        d.Get("admin_email").(string),
 		ConfigurationPath: d.Get("configuration_path").(string),
+		UserMode:          d.Get("user_mode").(string),
 	}
 
 	if v, ok := d.GetOk("email"); ok {
 		setup.Email = expandCtfdSetupEmailConfig(v.([]interface{}))
 	}
 
-	err := client.CreateCtfdSetup(setup)
+	return setup
+}
+
+func resourceCtfdSetupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	setup := expandCtfdSetup(d)
+
+	err := client.CreateCtfdSetup(ctx, setup)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -60,12 +89,24 @@ func resourceCtfdSetupRead(ctx context.Context, d *schema.ResourceData, meta int
 
 	var diags diag.Diagnostics
 
-	setup, err := client.GetCtfdSetup()
+	setup, err := client.GetCtfdSetup(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(setup.Name)
+	if err := d.Set("name", setup.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", setup.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_mode", setup.UserMode); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", flattenCtfdSetupEmailConfig(setup.Email)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -75,19 +116,27 @@ func resourceCtfdSetupUpdate(ctx context.Context, d *schema.ResourceData, meta i
 
 	var diags diag.Diagnostics
 
-	err := client.DeleteCtfdSetup()
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	setup := expandCtfdSetup(d)
 
-	setup := new(api.CtfdSetup)
-	setup.Name = d.Get("name").(string)
-	setup.Description = d.Get("description").(string)
-	setup.ConfigurationPath = d.Get("configuration_path").(string)
+	// only these need the instance reset and set up again; anything else is
+	// updated in place, so that drift, e.g. a rename in the admin panel, doesn't
+	// delete every account, submission and challenge on the next apply
+	if d.HasChanges("configuration_path", "user_mode", "admin_email") {
+		err := client.DeleteCtfdSetup(ctx)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	err = client.CreateCtfdSetup(*setup)
-	if err != nil {
-		return diag.FromErr(err)
+		// the reset discards everything, so the whole setup is repeated
+		err = client.CreateCtfdSetup(ctx, setup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		err := client.UpdateCtfdSetup(ctx, setup)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	d.SetId(setup.Name)
@@ -100,7 +149,7 @@ func resourceCtfdSetupDelete(ctx context.Context, d *schema.ResourceData, meta i
 
 	var diags diag.Diagnostics
 
-	err := client.DeleteCtfdSetup()
+	err := client.DeleteCtfdSetup(ctx)
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -108,6 +157,13 @@ func resourceCtfdSetupDelete(ctx context.Context, d *schema.ResourceData, meta i
 	return diags
 }
 
+// resourceCtfdSetupImport - refuse to import; `admin_email` and
+// `configuration_path` can't be read back, so the next apply would always see
+// a change and reset the instance
+func resourceCtfdSetupImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	return nil, errors.New("ctfd_setup can't be imported: admin_email and configuration_path can't be read back from CTFd, so the next apply would reset the instance, deleting all accounts, submissions and challenges")
+}
+
 func resourceCtfdSetup() *schema.Resource {
 	return &schema.Resource{
 		Description:   "Initial setup for a CTFd instance.",
@@ -115,6 +171,15 @@ func resourceCtfdSetup() *schema.Resource {
 		ReadContext:   resourceCtfdSetupRead,
 		UpdateContext: resourceCtfdSetupUpdate,
 		DeleteContext: resourceCtfdSetupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceCtfdSetupImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -129,12 +194,21 @@ func resourceCtfdSetup() *schema.Resource {
 				Required: true,
 			},
 			"admin_email": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Email address of the admin account. Changing it resets the instance.",
 			},
 			"configuration_path": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of a CTFd export to import. Changing it resets the instance.",
+			},
+			"user_mode": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          api.UserModeTeams,
+				Description:      "Either `teams`, for teams of users, or `users`, for individual players. Changing it resets the instance.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{api.UserModeTeams, api.UserModeUsers}, false)),
 			},
 			"email": {
 				Type:     schema.TypeList,
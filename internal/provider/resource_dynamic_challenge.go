@@ -0,0 +1,275 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandDynamicChallenge(d *schema.ResourceData) api.NewDynamicChallenge {
+	return api.NewDynamicChallenge{
+		NewChallenge: api.NewChallenge{
+			Name:           d.Get("name").(string),
+			Description:    d.Get("description").(string),
+			Category:       d.Get("category").(string),
+			Value:          d.Get("initial").(int),
+			State:          d.Get("state").(string),
+			MaxAttempts:    d.Get("max_attempts").(int),
+			ConnectionInfo: d.Get("connection_info").(string),
+		},
+		Initial:  d.Get("initial").(int),
+		Decay:    d.Get("decay").(int),
+		Minimum:  d.Get("minimum").(int),
+		Function: d.Get("function").(string),
+	}
+}
+
+func resourceDynamicChallengeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	newChallenge, err := client.CreateDynamicChallenge(ctx, expandDynamicChallenge(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(newChallenge.Id)))
+	if err := d.Set("value", newChallenge.Value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateChallengeTagsAndTopics(ctx, client, newChallenge.Id, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynamicChallengeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	challenge, err := client.GetDynamicChallenge(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(challenge.Id)))
+	if err := d.Set("name", challenge.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("description", challenge.Description); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("category", challenge.Category); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("value", challenge.Value); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("state", challenge.State); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("max_attempts", challenge.MaxAttempts); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("connection_info", challenge.ConnectionInfo); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("initial", challenge.Initial); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("decay", challenge.Decay); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("minimum", challenge.Minimum); err != nil {
+		return diag.FromErr(err)
+	}
+	// older CTFd releases predate the decay function and only decay logarithmically
+	if challenge.Function != "" {
+		if err := d.Set("function", challenge.Function); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err := readChallengeTagsAndTopics(ctx, client, challenge.Id, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynamicChallengeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedChallenge, err := client.UpdateDynamicChallenge(ctx, uint(intId), expandDynamicChallenge(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(updatedChallenge.Id)))
+	if err := d.Set("value", updatedChallenge.Value); err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = updateChallengeTagsAndTopics(ctx, client, updatedChallenge.Id, d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynamicChallengeDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.DeleteChallenge(ctx, uint(intId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceDynamicChallengeCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("initial") || !d.NewValueKnown("minimum") {
+		return nil
+	}
+
+	initial := d.Get("initial").(int)
+	minimum := d.Get("minimum").(int)
+	if minimum > initial {
+		return fmt.Errorf("minimum (%d) must not be greater than initial (%d)", minimum, initial)
+	}
+
+	return nil
+}
+
+func resourceDynamicChallenge() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a dynamic challenge, the value of which decays with each solve.",
+		CreateContext: resourceDynamicChallengeCreate,
+		ReadContext:   resourceDynamicChallengeRead,
+		UpdateContext: resourceDynamicChallengeUpdate,
+		DeleteContext: resourceDynamicChallengeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		CustomizeDiff: resourceDynamicChallengeCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"category": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"state": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "visible",
+				Description:      "One of `visible` or `hidden`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"visible", "hidden"}, false)),
+			},
+			"max_attempts": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				Description:      "Maximum number of attempts; `0` for unlimited.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"connection_info": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"initial": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				Description:      "Value of the challenge before any solves.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"decay": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				Description:      "For `linear`, the points deducted per solve; for `logarithmic`, the number of solves before `minimum` is reached.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(1)),
+			},
+			"minimum": {
+				Type:             schema.TypeInt,
+				Required:         true,
+				Description:      "Lowest value the challenge can decay to; must not exceed `initial`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"function": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "logarithmic",
+				Description:      "Decay function; one of `linear` or `logarithmic`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"linear", "logarithmic"}, false)),
+			},
+			"value": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Current value of the challenge.",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"topics": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func resourceFlagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	flag := api.NewFlag{
+		ChallengeId: uint(d.Get("challenge_id").(int)),
+		Type:        d.Get("type").(string),
+		Content:     d.Get("content").(string),
+		Data:        d.Get("data").(string),
+	}
+
+	newFlag, err := client.CreateFlag(ctx, flag)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(newFlag.Id)))
+
+	return diags
+}
+
+func resourceFlagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	flag, err := client.GetFlag(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(flag.Id)))
+	if err := d.Set("challenge_id", int(flag.ChallengeId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", flag.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("content", flag.Content); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("data", flag.Data); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceFlagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	flag := new(api.NewFlag)
+	flag.ChallengeId = uint(d.Get("challenge_id").(int))
+	flag.Type = d.Get("type").(string)
+	flag.Content = d.Get("content").(string)
+	flag.Data = d.Get("data").(string)
+
+	updatedFlag, err := client.UpdateFlag(ctx, uint(intId), *flag)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(updatedFlag.Id)))
+
+	return diags
+}
+
+func resourceFlagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.DeleteFlag(ctx, uint(intId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceFlag() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a flag for a challenge.",
+		CreateContext: resourceFlagCreate,
+		ReadContext:   resourceFlagRead,
+		UpdateContext: resourceFlagUpdate,
+		DeleteContext: resourceFlagDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"challenge_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "static",
+				Description:      "One of `static` or `regex`.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"static", "regex"}, false)),
+			},
+			"content": {
+				Type:      schema.TypeString,
+				Required:  true,
+				Sensitive: true,
+			},
+			"data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "",
+				Description:      "Either `case_insensitive` or empty for a case-sensitive match.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.StringInSlice([]string{"", "case_insensitive"}, false)),
+			},
+		},
+	}
+}
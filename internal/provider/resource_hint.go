@@ -0,0 +1,209 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func expandHintRequirements(l []interface{}) *api.HintRequirements {
+	requirements := &api.HintRequirements{
+		Prerequisites: []uint{},
+	}
+
+	if len(l) == 0 || l[0] == nil {
+		return requirements
+	}
+
+	m := l[0].(map[string]interface{})
+	for _, v := range m["prerequisites"].(*schema.Set).List() {
+		requirements.Prerequisites = append(requirements.Prerequisites, uint(v.(int)))
+	}
+
+	return requirements
+}
+
+func flattenHintRequirements(requirements *api.HintRequirements) []interface{} {
+	if requirements == nil || len(requirements.Prerequisites) == 0 {
+		return []interface{}{}
+	}
+
+	prerequisites := make([]interface{}, 0, len(requirements.Prerequisites))
+	for _, v := range requirements.Prerequisites {
+		prerequisites = append(prerequisites, int(v))
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"prerequisites": prerequisites,
+		},
+	}
+}
+
+func expandHint(d *schema.ResourceData) api.NewHint {
+	return api.NewHint{
+		ChallengeId:  uint(d.Get("challenge_id").(int)),
+		Title:        d.Get("title").(string),
+		Content:      d.Get("content").(string),
+		Cost:         d.Get("cost").(int),
+		Requirements: expandHintRequirements(d.Get("requirements").([]interface{})),
+	}
+}
+
+func resourceHintCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	newHint, err := client.CreateHint(ctx, expandHint(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(newHint.Id)))
+
+	return diags
+}
+
+func resourceHintRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	hint, err := client.GetHint(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(hint.Id)))
+	if err := d.Set("challenge_id", int(hint.ChallengeId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("title", hint.Title); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("content", hint.Content); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("cost", hint.Cost); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("requirements", flattenHintRequirements(hint.Requirements)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceHintUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	updatedHint, err := client.UpdateHint(ctx, uint(intId), expandHint(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(strconv.Itoa(int(updatedHint.Id)))
+
+	return diags
+}
+
+func resourceHintDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	client := meta.(*api.Client)
+
+	var diags diag.Diagnostics
+
+	intId, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	err = client.DeleteHint(ctx, uint(intId))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return diags
+}
+
+func resourceHint() *schema.Resource {
+	return &schema.Resource{
+		Description:   "Manage a hint for a challenge.",
+		CreateContext: resourceHintCreate,
+		ReadContext:   resourceHintRead,
+		UpdateContext: resourceHintUpdate,
+		DeleteContext: resourceHintDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"challenge_id": {
+				Type:     schema.TypeInt,
+				Required: true,
+				ForceNew: true,
+			},
+			"title": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"content": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"cost": {
+				Type:             schema.TypeInt,
+				Optional:         true,
+				Default:          0,
+				Description:      "Points deducted when the hint is unlocked.",
+				ValidateDiagFunc: validation.ToDiagFunc(validation.IntAtLeast(0)),
+			},
+			"requirements": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"prerequisites": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							MinItems:    1,
+							Description: "IDs of hints which must be unlocked first.",
+							Elem: &schema.Schema{
+								Type: schema.TypeInt,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -2,17 +2,41 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// requireTeamsMode - an error unless CTFd is in teams mode; in users mode
+// there are no teams, so every request concerning them would fail
+func requireTeamsMode(ctx context.Context, client *api.Client, name string) diag.Diagnostics {
+	userMode, err := client.GetUserMode(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if userMode != api.UserModeTeams {
+		return diag.Diagnostics{
+			{
+				Severity: diag.Error,
+				Summary:  fmt.Sprintf("%s requires CTFd to be in teams mode", name),
+				Detail:   fmt.Sprintf("CTFd is in %q mode, in which there are no teams; set `user_mode = \"teams\"` on `ctfd_setup` to use %s.", userMode, name),
+			},
+		}
+	}
+
+	return nil
+}
+
 func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var diags diag.Diagnostics
+	if diags := requireTeamsMode(ctx, client, "ctfd_team"); diags.HasError() {
+		return diags
+	}
 
 	team := api.NewTeam{
 		Name:        d.Get("name").(string),
@@ -25,22 +49,14 @@ func resourceTeamCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		Banned:      d.Get("banned").(bool),
 	}
 
-	newTeam, err := client.CreateTeam(team)
+	newTeam, err := client.CreateTeam(ctx, team)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(int(newTeam.Id)))
-	err = d.Set("members", newTeam.Members)
-	if err != nil {
-		return nil
-	}
-	err = d.Set("fields", newTeam.Fields)
-	if err != nil {
-		return nil
-	}
 
-	return diags
+	return resourceTeamRead(ctx, d, meta)
 }
 
 func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -52,14 +68,64 @@ func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	intId, err := strconv.Atoi(id)
 	if err != nil {
-		return nil
+		return diag.FromErr(err)
+	}
+	team, err := client.GetTeam(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
 	}
-	team, err := client.GetTeam(uint(intId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(int(team.Id)))
+	if err := d.Set("name", team.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", team.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("website", team.Website); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("affiliation", team.Affiliation); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("country", team.Country); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hidden", team.Hidden); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("banned", team.Banned); err != nil {
+		return diag.FromErr(err)
+	}
+	captainId := 0
+	if team.CaptainId != nil {
+		captainId = int(*team.CaptainId)
+	}
+	if err := d.Set("captain_id", captainId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("bracket", team.Bracket); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret", team.Secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("oauth_id", flattenOauthId(team.OauthId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("members", team.Members); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created", team.Created); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fields", flattenFieldEntries(team.Fields)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -67,7 +133,9 @@ func resourceTeamRead(ctx context.Context, d *schema.ResourceData, meta interfac
 func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var diags diag.Diagnostics
+	if diags := requireTeamsMode(ctx, client, "ctfd_team"); diags.HasError() {
+		return diags
+	}
 
 	id := d.Id()
 
@@ -86,14 +154,14 @@ func resourceTeamUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	team.Hidden = d.Get("hidden").(bool)
 	team.Banned = d.Get("banned").(bool)
 
-	updatedTeam, err := client.UpdateTeam(uint(intId), *team)
+	updatedTeam, err := client.UpdateTeam(ctx, uint(intId), *team)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(int(updatedTeam.Id)))
 
-	return diags
+	return resourceTeamRead(ctx, d, meta)
 }
 
 func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -107,7 +175,7 @@ func resourceTeamDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return nil
 	}
-	err = client.DeleteTeam(uint(intId))
+	err = client.DeleteTeam(ctx, uint(intId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -122,6 +190,15 @@ func resourceTeam() *schema.Resource {
 		ReadContext:   resourceTeamRead,
 		UpdateContext: resourceTeamUpdate,
 		DeleteContext: resourceTeamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -160,9 +237,10 @@ func resourceTeam() *schema.Resource {
 				Optional: true,
 			},
 			"captain_id": {
-				Type:     schema.TypeInt,
-				Computed: true,
-				Optional: true,
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Optional:    true,
+				Description: "ID of the team's captain; `0` if there isn't one.",
 			},
 			"bracket": {
 				Type:     schema.TypeString,
@@ -190,13 +268,7 @@ func resourceTeam() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"fields": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
+			"fields": fieldEntriesSchema(),
 		},
 	}
 }
@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"strconv"
+	"time"
 
 	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -12,8 +13,6 @@ import (
 func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var diags diag.Diagnostics
-
 	user := api.NewUser{
 		Name:        d.Get("name").(string),
 		Email:       d.Get("email").(string),
@@ -27,19 +26,14 @@ func resourceUserCreate(ctx context.Context, d *schema.ResourceData, meta interf
 		Banned:      d.Get("banned").(bool),
 	}
 
-	newUser, err := client.CreateUser(user)
+	newUser, err := client.CreateUser(ctx, user)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(int(newUser.Id)))
 
-	err = d.Set("fields", newUser.Fields)
-	if err != nil {
-		return nil
-	}
-
-	return diags
+	return resourceUserRead(ctx, d, meta)
 }
 
 func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -51,14 +45,63 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 
 	intId, err := strconv.Atoi(id)
 	if err != nil {
-		return nil
+		return diag.FromErr(err)
+	}
+	user, err := client.GetUser(ctx, uint(intId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
 	}
-	user, err := client.GetUser(uint(intId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(int(user.Id)))
+	if err := d.Set("name", user.Name); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("email", user.Email); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("website", user.Website); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("affiliation", user.Affiliation); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("country", user.Country); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("hidden", user.Hidden); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("banned", user.Banned); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("bracket", user.Bracket); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("secret", user.Secret); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("oauth_id", flattenOauthId(user.OauthId)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("created", user.Created); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("fields", flattenFieldEntries(user.Fields)); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("verified", user.Verified); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("type", user.Type); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("team_id", int(user.TeamId)); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -66,8 +109,6 @@ func resourceUserRead(ctx context.Context, d *schema.ResourceData, meta interfac
 func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var diags diag.Diagnostics
-
 	id := d.Id()
 
 	intId, err := strconv.Atoi(id)
@@ -87,14 +128,14 @@ func resourceUserUpdate(ctx context.Context, d *schema.ResourceData, meta interf
 	user.Banned = d.Get("banned").(bool)
 	user.Type = d.Get("type").(string)
 
-	updatedUser, err := client.UpdateUser(uint(intId), *user)
+	updatedUser, err := client.UpdateUser(ctx, uint(intId), *user)
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	d.SetId(strconv.Itoa(int(updatedUser.Id)))
 
-	return diags
+	return resourceUserRead(ctx, d, meta)
 }
 
 func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -108,7 +149,7 @@ func resourceUserDelete(ctx context.Context, d *schema.ResourceData, meta interf
 	if err != nil {
 		return nil
 	}
-	err = client.DeleteUser(uint(intId))
+	err = client.DeleteUser(ctx, uint(intId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -123,6 +164,15 @@ func resourceUser() *schema.Resource {
 		ReadContext:   resourceUserRead,
 		UpdateContext: resourceUserUpdate,
 		DeleteContext: resourceUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,
@@ -179,13 +229,7 @@ func resourceUser() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
-			"fields": {
-				Type:     schema.TypeList,
-				Computed: true,
-				Elem: &schema.Schema{
-					Type: schema.TypeString,
-				},
-			},
+			"fields": fieldEntriesSchema(),
 			"verified": {
 				Type:     schema.TypeBool,
 				Optional: true,
@@ -5,21 +5,45 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/PsypherPunk/terraform-provider-ctfd/internal/api"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
+// parseUserTeamMembershipId - split a `team_id.user_id` ID into its parts
+func parseUserTeamMembershipId(id string) (int, int, error) {
+	ids := strings.SplitN(id, ".", 2)
+	if len(ids) != 2 {
+		return 0, 0, fmt.Errorf("invalid ID %q; expected team_id.user_id", id)
+	}
+
+	teamIntId, err := strconv.Atoi(ids[0])
+	if err != nil {
+		return 0, 0, err
+	}
+	userIntId, err := strconv.Atoi(ids[1])
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return teamIntId, userIntId, nil
+}
+
 func resourceUserTeamMembershipCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
+	if diags := requireTeamsMode(ctx, client, "ctfd_user_team_membership"); diags.HasError() {
+		return diags
+	}
+
 	var diags diag.Diagnostics
 
 	user_id := d.Get("user_id").(int)
 	team_id := d.Get("team_id").(int)
 
-	newUserTeamMembership, err := client.CreateUserTeamMembership(uint(team_id), uint(user_id))
+	newUserTeamMembership, err := client.CreateUserTeamMembership(ctx, uint(team_id), uint(user_id))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -34,30 +58,31 @@ func resourceUserTeamMembershipRead(ctx context.Context, d *schema.ResourceData,
 
 	var diags diag.Diagnostics
 
-	id := d.Id()
-	ids := strings.SplitN(id, ".", 2)
-	teamId := ids[0]
-	userId := ids[1]
-
-	teamIntId, err := strconv.Atoi(teamId)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	userIntId, err := strconv.Atoi(userId)
+	teamIntId, userIntId, err := parseUserTeamMembershipId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	members, err := client.GetTeamMemberships(uint(teamIntId))
+	members, err := client.GetTeamMemberships(ctx, uint(teamIntId))
+	if api.IsNotFound(err) {
+		d.SetId("")
+		return diags
+	}
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
 	if !api.Contains(*members, uint(userIntId)) {
-		return diag.FromErr(fmt.Errorf("member %d not found in team %d", userIntId, teamIntId))
+		d.SetId("")
+		return diags
 	}
 
-	d.SetId(id)
+	if err := d.Set("team_id", teamIntId); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("user_id", userIntId); err != nil {
+		return diag.FromErr(err)
+	}
 
 	return diags
 }
@@ -65,31 +90,26 @@ func resourceUserTeamMembershipRead(ctx context.Context, d *schema.ResourceData,
 func resourceUserTeamMembershipUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	client := meta.(*api.Client)
 
-	var diags diag.Diagnostics
+	if diags := requireTeamsMode(ctx, client, "ctfd_user_team_membership"); diags.HasError() {
+		return diags
+	}
 
-	id := d.Id()
-	ids := strings.SplitN(id, ".", 2)
-	teamId := ids[0]
-	userId := ids[1]
+	var diags diag.Diagnostics
 
-	teamIntId, err := strconv.Atoi(teamId)
-	if err != nil {
-		return diag.FromErr(err)
-	}
-	userIntId, err := strconv.Atoi(userId)
+	teamIntId, userIntId, err := parseUserTeamMembershipId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.DeleteUserTeamMembership(uint(teamIntId), uint(userIntId))
+	err = client.DeleteUserTeamMembership(ctx, uint(teamIntId), uint(userIntId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	teamUintId := d.Get("user_id").(int)
-	userUintId := d.Get("team_id").(int)
+	teamUintId := d.Get("team_id").(int)
+	userUintId := d.Get("user_id").(int)
 
-	newUserTeamMembership, err := client.CreateUserTeamMembership(uint(teamUintId), uint(userUintId))
+	newUserTeamMembership, err := client.CreateUserTeamMembership(ctx, uint(teamUintId), uint(userUintId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
@@ -104,26 +124,26 @@ func resourceUserTeamMembershipDelete(ctx context.Context, d *schema.ResourceDat
 
 	var diags diag.Diagnostics
 
-	id := d.Id()
-	ids := strings.SplitN(id, ".", 2)
-	teamId := ids[0]
-	userId := ids[1]
-
-	teamIntId, err := strconv.Atoi(teamId)
+	teamIntId, userIntId, err := parseUserTeamMembershipId(d.Id())
 	if err != nil {
 		return diag.FromErr(err)
 	}
-	userIntId, err := strconv.Atoi(userId)
+
+	err = client.DeleteUserTeamMembership(ctx, uint(teamIntId), uint(userIntId))
 	if err != nil {
 		return diag.FromErr(err)
 	}
 
-	err = client.DeleteUserTeamMembership(uint(teamIntId), uint(userIntId))
+	return diags
+}
+
+func resourceUserTeamMembershipImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_, _, err := parseUserTeamMembershipId(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return nil, err
 	}
 
-	return diags
+	return []*schema.ResourceData{d}, nil
 }
 
 func resourceUserTeamMembership() *schema.Resource {
@@ -133,6 +153,15 @@ func resourceUserTeamMembership() *schema.Resource {
 		ReadContext:   resourceUserTeamMembershipRead,
 		UpdateContext: resourceUserTeamMembershipUpdate,
 		DeleteContext: resourceUserTeamMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceUserTeamMembershipImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
 		Schema: map[string]*schema.Schema{
 			"id": {
 				Type:     schema.TypeString,